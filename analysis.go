@@ -0,0 +1,154 @@
+package golay
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Codewords returns all 4096 codewords of the Golay(23,12) code, indexed by their 12-bit data.
+func Codewords() []Codeword {
+	codewords := make([]Codeword, 1<<12)
+	for d := range codewords {
+		codewords[d] = NewCodeword(uint16(d))
+	}
+	return codewords
+}
+
+// GeneratorMatrix returns the parity part of the generator matrix.
+// Parity bit 10-i of the data d is the parity of d & GeneratorMatrix()[i],
+// where bit 0 is the least significant bit.
+func GeneratorMatrix() [11]uint16 {
+	return g
+}
+
+// ParityCheckMatrix returns the parity check matrix.
+// Syndrome bit 10-i of the 23-bit word w is the parity of w & ParityCheckMatrix()[i].
+func ParityCheckMatrix() [11]uint32 {
+	return h
+}
+
+// WeightDistribution returns the weight distribution of the Golay(23,12) code.
+// The i-th element is the number of codewords of Hamming weight i:
+// 1 codeword of weight 0, 253 of weight 7, 506 of weight 8, 1288 of weight 11, and so on.
+func WeightDistribution() []int {
+	return weightDistribution(Codewords())
+}
+
+// DualCodewords returns all 2048 codewords of the dual code, the (23,11) code
+// generated by the rows of the parity check matrix.
+// Every dual codeword is orthogonal to every Golay codeword.
+func DualCodewords() []Codeword {
+	codewords := make([]Codeword, 1<<11)
+	for s := range codewords {
+		var c uint32
+		for i := range 11 {
+			if s&(1<<(10-i)) != 0 {
+				c ^= h[i]
+			}
+		}
+		codewords[s] = Codeword(c)
+	}
+	return codewords
+}
+
+// DualWeightDistribution returns the weight distribution of the dual code.
+// The i-th element is the number of dual codewords of Hamming weight i.
+func DualWeightDistribution() []int {
+	return weightDistribution(DualCodewords())
+}
+
+// CosetLeader returns the coset leader for the given 11-bit syndrome:
+// the minimum weight error pattern that Decode corrects for that syndrome.
+// The coset leader of syndrome 0 is 0.
+func CosetLeader(syndrome uint16) Codeword {
+	return Codeword(corrections[syndrome&0x7FF])
+}
+
+// CosetLeaders returns the coset leaders of all 2048 syndromes, indexed by syndrome.
+// Because Golay(23,12) is a perfect code, the leaders are exactly the 2048 error patterns
+// of weight 0 to 3.
+func CosetLeaders() []Codeword {
+	leaders := make([]Codeword, 1<<11)
+	for s := range leaders {
+		leaders[s] = CosetLeader(uint16(s))
+	}
+	return leaders
+}
+
+// MacWilliams applies the MacWilliams transform to the weight distribution a
+// of a binary linear code of length len(a)-1 and dimension k,
+// and returns the weight distribution of its dual code.
+// It returns an error if k is not in [0, len(a)-1] or a is not the weight
+// distribution of such a code.
+func MacWilliams(a []int, k int) ([]int, error) {
+	n := len(a) - 1
+	if n < 0 {
+		return nil, fmt.Errorf("golay: weight distribution must not be empty")
+	}
+	if k < 0 || k > n {
+		return nil, fmt.Errorf("golay: dimension %d out of range [0, %d]", k, n)
+	}
+	b := make([]int, n+1)
+	for j := range b {
+		var sum int64
+		for i, ai := range a {
+			sum += int64(ai) * krawtchouk(n, j, i)
+		}
+		if sum%(1<<k) != 0 || sum < 0 {
+			return nil, fmt.Errorf("golay: weight distribution is not a code of dimension %d: B_%d = %d/2^%d", k, j, sum, k)
+		}
+		b[j] = int(sum >> k)
+	}
+	return b, nil
+}
+
+// CheckMacWilliams verifies that the weight distributions of the Golay(23,12) code
+// and its dual, computed by enumeration, satisfy the MacWilliams identities in both directions.
+func CheckMacWilliams() error {
+	a, b := WeightDistribution(), DualWeightDistribution()
+	if got, err := MacWilliams(a, 12); err != nil {
+		return err
+	} else if !slices.Equal(got, b) {
+		return fmt.Errorf("golay: MacWilliams transform of the code is %v, want dual distribution %v", got, b)
+	}
+	if got, err := MacWilliams(b, 11); err != nil {
+		return err
+	} else if !slices.Equal(got, a) {
+		return fmt.Errorf("golay: MacWilliams transform of the dual is %v, want code distribution %v", got, a)
+	}
+	return nil
+}
+
+// weightDistribution counts the codewords of each weight from 0 to 23.
+func weightDistribution(codewords []Codeword) []int {
+	dist := make([]int, 24)
+	for _, c := range codewords {
+		dist[c.Weight()]++
+	}
+	return dist
+}
+
+// krawtchouk returns the Krawtchouk polynomial K_j(i) for length n.
+func krawtchouk(n, j, i int) int64 {
+	var k int64
+	for s := 0; s <= j; s++ {
+		term := binomial(i, s) * binomial(n-i, j-s)
+		if s%2 == 1 {
+			term = -term
+		}
+		k += term
+	}
+	return k
+}
+
+// binomial returns the binomial coefficient C(n, k), or 0 if k is out of range.
+func binomial(n, k int) int64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	var c int64 = 1
+	for i := range k {
+		c = c * int64(n-i) / int64(i+1)
+	}
+	return c
+}
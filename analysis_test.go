@@ -0,0 +1,88 @@
+package golay
+
+import (
+	"math/bits"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAnalysis(t *testing.T) {
+	t.Run("Matrices", func(t *testing.T) {
+		gm, hm := GeneratorMatrix(), ParityCheckMatrix()
+		for d := range uint16(4096) {
+			var parity uint16
+			for i, row := range gm {
+				parity |= uint16(bits.OnesCount16(d&row)%2) << (10 - i)
+			}
+			if want := Encode(d); parity != want {
+				t.Fatalf("GeneratorMatrix() failed: parity of %d is %d, want %d", d, parity, want)
+			}
+			for i, row := range hm {
+				if bits.OnesCount32(EncodeWord(d)&row)%2 != 0 {
+					t.Fatalf("ParityCheckMatrix() failed: row %d is not orthogonal to codeword of %d", i, d)
+				}
+			}
+		}
+	})
+	t.Run("WeightDistribution", func(t *testing.T) {
+		want := make([]int, 24)
+		want[0], want[7], want[8], want[11], want[12], want[15], want[16], want[23] = 1, 253, 506, 1288, 1288, 506, 253, 1
+		if got := WeightDistribution(); !slices.Equal(got, want) {
+			t.Errorf("WeightDistribution() failed: got %v, want %v", got, want)
+		}
+	})
+	t.Run("DualWeightDistribution", func(t *testing.T) {
+		want := make([]int, 24)
+		want[0], want[8], want[12], want[16] = 1, 506, 1288, 253
+		if got := DualWeightDistribution(); !slices.Equal(got, want) {
+			t.Errorf("DualWeightDistribution() failed: got %v, want %v", got, want)
+		}
+		codewords := Codewords()
+		for _, d := range DualCodewords() {
+			for _, c := range codewords {
+				if bits.OnesCount32(uint32(c&d))%2 != 0 {
+					t.Fatalf("dual codeword %v is not orthogonal to %v", d, c)
+				}
+			}
+		}
+	})
+	t.Run("CosetLeaders", func(t *testing.T) {
+		weights := make([]int, 24)
+		for s, leader := range CosetLeaders() {
+			if leader.Syndrome() != uint16(s) {
+				t.Fatalf("CosetLeader(%d) failed: leader %v has syndrome %d", s, leader, leader.Syndrome())
+			}
+			weights[leader.Weight()]++
+		}
+		// perfect code: all error patterns of weight <= 3
+		want := make([]int, 24)
+		want[0], want[1], want[2], want[3] = 1, 23, 253, 1771
+		if !slices.Equal(weights, want) {
+			t.Errorf("CosetLeaders() weight distribution failed: got %v, want %v", weights, want)
+		}
+	})
+	t.Run("MacWilliams", func(t *testing.T) {
+		if err := CheckMacWilliams(); err != nil {
+			t.Errorf("CheckMacWilliams() failed: %v", err)
+		}
+		// repetition code of length 3 and its dual, the even weight code
+		if got, err := MacWilliams([]int{1, 0, 0, 1}, 1); err != nil || !slices.Equal(got, []int{1, 0, 3, 0}) {
+			t.Errorf("MacWilliams() failed: got %v, %v, want %v", got, err, []int{1, 0, 3, 0})
+		}
+		if _, err := MacWilliams([]int{1, 2, 0, 0}, 1); err == nil {
+			t.Errorf("MacWilliams() should reject a distribution that is not a linear code")
+		}
+		for _, k := range []int{-1, 4} {
+			if _, err := MacWilliams([]int{1, 0, 0, 1}, k); err == nil || !strings.HasPrefix(err.Error(), "golay: ") {
+				t.Errorf("MacWilliams(k=%d) failed: got %v, want a golay: error", k, err)
+			}
+		}
+		if got, err := MacWilliams([]int{1, 0, 0, 0}, 0); err != nil || !slices.Equal(got, []int{1, 3, 3, 1}) {
+			t.Errorf("MacWilliams(k=0) failed: got %v, %v, want %v", got, err, []int{1, 3, 3, 1})
+		}
+		if got, err := MacWilliams([]int{1, 3, 3, 1}, 3); err != nil || !slices.Equal(got, []int{1, 0, 0, 0}) {
+			t.Errorf("MacWilliams(k=3) failed: got %v, %v, want %v", got, err, []int{1, 0, 0, 0})
+		}
+	})
+}
@@ -0,0 +1,77 @@
+// Command capi exports the golay package as a C library.
+//
+// Build a shared library and its cgo generated header with:
+//
+//	go build -buildmode=c-shared -o libgolay.so ./capi
+//
+// C and C++ code should include golay.h from this directory, which declares
+// the exported functions with documentation and links against libgolay.so.
+// All buffers are MSB-aligned bit streams, matching the golay stream API.
+package main
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/yyyoichi/golay"
+)
+
+func main() {}
+
+//export golay_encode
+func golay_encode(data C.uint16_t) C.uint16_t {
+	return C.uint16_t(golay.Encode(uint16(data)))
+}
+
+//export golay_encode_word
+func golay_encode_word(data C.uint16_t) C.uint32_t {
+	return C.uint32_t(golay.EncodeWord(uint16(data)))
+}
+
+//export golay_decode
+func golay_decode(codeword C.uint32_t) C.uint16_t {
+	return C.uint16_t(golay.Decode(uint32(codeword)))
+}
+
+//export golay_encoded_bits
+func golay_encoded_bits(bits C.size_t) C.size_t {
+	return C.size_t(golay.EncodedBits(int(bits)))
+}
+
+//export golay_decoded_bits
+func golay_decoded_bits(bits C.size_t) C.size_t {
+	return C.size_t(golay.DecodedBits(int(bits)))
+}
+
+//export golay_encode_buffer
+func golay_encode_buffer(in *C.uint8_t, inBits C.size_t, out *C.uint8_t, outCap C.size_t) C.int64_t {
+	n := golay.EncodedBits(int(inBits))
+	if (n+7)/8 > int(outCap) {
+		return -1
+	}
+	if inBits == 0 {
+		return 0
+	}
+	data := unsafe.Slice((*uint8)(unsafe.Pointer(in)), (int(inBits)+7)/8)
+	v := unsafe.Slice((*uint8)(unsafe.Pointer(out)), int(outCap))[:0]
+	return C.int64_t(golay.EncodeSlice(data, int(inBits), &v))
+}
+
+//export golay_decode_buffer
+func golay_decode_buffer(in *C.uint8_t, inBits C.size_t, out *C.uint8_t, outCap C.size_t) C.int64_t {
+	n := golay.DecodedBits(int(inBits))
+	if (n+7)/8 > int(outCap) {
+		return -1
+	}
+	if n == 0 {
+		return 0
+	}
+	data := unsafe.Slice((*uint8)(unsafe.Pointer(in)), (int(inBits)+7)/8)
+	v := unsafe.Slice((*uint8)(unsafe.Pointer(out)), int(outCap))[:0]
+	return C.int64_t(golay.DecodeSlice(data, int(inBits), &v))
+}
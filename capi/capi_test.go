@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/yyyoichi/golay"
+)
+
+// TestC builds the shared library, compiles testdata/capi_test.c against golay.h
+// and compares its output with the Go implementation.
+func TestC(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("C API test runs on Linux only")
+	}
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	if out, err := exec.Command("go", "env", "CGO_ENABLED").Output(); err != nil || strings.TrimSpace(string(out)) != "1" {
+		t.Skip("cgo is disabled")
+	}
+
+	dir := t.TempDir()
+	build := exec.Command("go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "libgolay.so"), ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("building the shared library failed: %v\n%s", err, out)
+	}
+	bin := filepath.Join(dir, "capi_test")
+	compile := exec.Command(cc, "-Wall", "-Werror", "-I.", "-o", bin, filepath.Join("testdata", "capi_test.c"), "-L"+dir, "-lgolay")
+	if out, err := compile.CombinedOutput(); err != nil {
+		t.Fatalf("compiling the C test failed: %v\n%s", err, out)
+	}
+	run := exec.Command(bin)
+	run.Env = append(os.Environ(), "LD_LIBRARY_PATH="+dir)
+	out, err := run.Output()
+	if err != nil {
+		t.Fatalf("running the C test failed: %v", err)
+	}
+
+	var want strings.Builder
+	for d := range uint16(4096) {
+		c := golay.EncodeWord(d)
+		fmt.Fprintf(&want, "word %d %d %d %d %d\n", d, golay.Encode(d), c, golay.Decode(c^0x400001), golay.Decode(c^0x7))
+	}
+	in := []uint8{0xDE, 0xAD, 0xBE, 0xEF, 0x42}
+	var encoded []uint8
+	n := golay.EncodeSlice(in, 36, &encoded)
+	fmt.Fprintf(&want, "encode %d%s\n", n, hexBytes(encoded))
+	encoded[1] ^= 0x10
+	var decoded []uint8
+	n = golay.DecodeSlice(encoded, n, &decoded)
+	fmt.Fprintf(&want, "decode %d%s\n", n, hexBytes(decoded))
+	fmt.Fprintf(&want, "small -1 -1\n")
+	fmt.Fprintf(&want, "bits %d %d\n", golay.EncodedBits(100), golay.DecodedBits(184))
+
+	gotLines := strings.Split(string(out), "\n")
+	wantLines := strings.Split(want.String(), "\n")
+	if len(gotLines) != len(wantLines) {
+		t.Fatalf("C output has %d lines, want %d", len(gotLines), len(wantLines))
+	}
+	for i := range wantLines {
+		if gotLines[i] != wantLines[i] {
+			t.Errorf("line %d differs: got %q, want %q", i, gotLines[i], wantLines[i])
+		}
+	}
+}
+
+func hexBytes(b []uint8) string {
+	var sb strings.Builder
+	for _, v := range b {
+		fmt.Fprintf(&sb, " %02x", v)
+	}
+	return sb.String()
+}
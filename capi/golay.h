@@ -0,0 +1,53 @@
+/*
+ * C API of github.com/yyyoichi/golay, Golay(23,12) encoding and decoding.
+ *
+ * Build the library with:
+ *
+ *     go build -buildmode=c-shared -o libgolay.so ./capi
+ *
+ * and link with -lgolay. Results are bit-exact with the Go implementation.
+ */
+#ifndef GOLAY_H
+#define GOLAY_H
+
+#include <stddef.h>
+#include <stdint.h>
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+/* Encodes 12-bit data into 11-bit parity. Input bits above 12 are ignored. */
+extern uint16_t golay_encode(uint16_t data);
+
+/* Encodes 12-bit data into a 23-bit codeword [data(12) | parity(11)]. */
+extern uint32_t golay_encode_word(uint16_t data);
+
+/* Decodes a 23-bit codeword into 12-bit data, correcting up to 3-bit errors. */
+extern uint16_t golay_decode(uint32_t codeword);
+
+/* Returns the number of bits produced by encoding bits input bits. */
+extern size_t golay_encoded_bits(size_t bits);
+
+/* Returns the number of bits produced by decoding bits encoded bits. */
+extern size_t golay_decoded_bits(size_t bits);
+
+/*
+ * Encodes the first in_bits bits of the MSB-aligned buffer in, split into 12-bit blocks,
+ * into the MSB-aligned buffer out of out_cap bytes.
+ * Returns the number of encoded bits, or -1 if out is too small.
+ */
+extern int64_t golay_encode_buffer(const uint8_t *in, size_t in_bits, uint8_t *out, size_t out_cap);
+
+/*
+ * Decodes the first in_bits bits of the MSB-aligned buffer in, split into 23-bit blocks,
+ * into the MSB-aligned buffer out of out_cap bytes. Any remainder shorter than a block is ignored.
+ * Returns the number of decoded bits, or -1 if out is too small.
+ */
+extern int64_t golay_decode_buffer(const uint8_t *in, size_t in_bits, uint8_t *out, size_t out_cap);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif /* GOLAY_H */
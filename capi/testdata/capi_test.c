@@ -0,0 +1,30 @@
+/* Prints the results of the C API for comparison with the Go implementation. */
+#include <stdio.h>
+#include "golay.h"
+
+int main(void) {
+	for (uint16_t d = 0; d < 4096; d++) {
+		uint32_t c = golay_encode_word(d);
+		printf("word %u %u %u %u %u\n", d, golay_encode(d), c,
+		       golay_decode(c ^ 0x400001u), golay_decode(c ^ 0x7u));
+	}
+
+	uint8_t in[5] = {0xDE, 0xAD, 0xBE, 0xEF, 0x42};
+	uint8_t encoded[16] = {0};
+	int64_t n = golay_encode_buffer(in, 36, encoded, sizeof(encoded));
+	printf("encode %lld", (long long)n);
+	for (size_t i = 0; i < (size_t)(n + 7) / 8; i++) printf(" %02x", encoded[i]);
+	printf("\n");
+
+	encoded[1] ^= 0x10;
+	uint8_t decoded[8] = {0};
+	n = golay_decode_buffer(encoded, (size_t)n, decoded, sizeof(decoded));
+	printf("decode %lld", (long long)n);
+	for (size_t i = 0; i < (size_t)(n + 7) / 8; i++) printf(" %02x", decoded[i]);
+	printf("\n");
+
+	printf("small %lld %lld\n", (long long)golay_encode_buffer(in, 36, encoded, 8),
+	       (long long)golay_decode_buffer(encoded, 69, decoded, 4));
+	printf("bits %zu %zu\n", golay_encoded_bits(100), golay_decoded_bits(184));
+	return 0;
+}
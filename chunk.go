@@ -0,0 +1,131 @@
+package golay
+
+import "reflect"
+
+// ChunkDecoder performs incremental Golay decoding on MSB-aligned binary data
+// that arrives in pieces, such as reads from a network connection.
+// Chunks do not need to be aligned to 23-bit block boundaries:
+// bits that do not complete a block are kept until the next Decode call.
+// Each completed block is decoded immediately and its 12 data bits are
+// appended to the output slice specified at creation time.
+type ChunkDecoder[T BinaryValue] struct {
+	writer    sliceWriter
+	outputPtr *[]T
+	hook      CorrectionHook
+	policy    Policy
+	rejected  []int
+	pending   uint32 // bits of an incomplete block, right-aligned
+	pendBits  int    // number of valid bits in pending
+	blocks    int    // number of decoded blocks
+}
+
+// NewChunkDecoder creates a new ChunkDecoder that appends decoded data to v.
+// v must be a pointer to a slice of BinaryValue type.
+// Existing elements of *v are kept and decoded data is written after them.
+func NewChunkDecoder[T BinaryValue](v *[]T) *ChunkDecoder[T] {
+	if v == nil {
+		panic("v must not be nil")
+	}
+	writer := newSliceWriter[T]()
+	size := bitSize[T]()
+	for _, x := range *v {
+		writer.Write64(64-size, size, uint64(x))
+	}
+	return &ChunkDecoder[T]{
+		writer:    writer,
+		outputPtr: v,
+	}
+}
+
+// SetCorrectionHook registers hook to be called for every block that required error correction.
+// The Block field of each Correction is the block index counted from the first chunk.
+// Passing nil removes the hook.
+func (d *ChunkDecoder[T]) SetCorrectionHook(hook CorrectionHook) {
+	d.hook = hook
+}
+
+// SetPolicy sets the decoding policy for blocks completed by subsequent Decode calls.
+// The default is CorrectAll. Rejected blocks are written to the output uncorrected
+// and their indices are reported by Rejected and by the *DecodeError returned from Decode.
+func (d *ChunkDecoder[T]) SetPolicy(policy Policy) {
+	d.policy = policy
+}
+
+// Rejected returns the indices of all blocks rejected by the policy so far,
+// counted from the first chunk, in ascending order.
+func (d *ChunkDecoder[T]) Rejected() []int {
+	return d.rejected
+}
+
+// Decode consumes a chunk of encoded data and appends the decoded data of every
+// block completed by this chunk to the output slice.
+// data must be a slice of BinaryValue type ([]uint8, []uint16, []uint32, []uint64, or []uint).
+// The bits parameter specifies how many bits in the chunk are valid.
+// If bits is 0, all bits in the chunk are considered valid.
+// If blocks completed by this chunk are rejected by the policy, the output is still
+// appended and a *DecodeError listing those blocks is returned.
+func (d *ChunkDecoder[T]) Decode(data any, bits int) error {
+	if data == nil {
+		return ErrNilData
+	}
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice {
+		return ErrNotSlice
+	}
+	reader, err := newSliceReader(rv)
+	if err != nil {
+		return err
+	}
+	if bits > 0 {
+		reader.SetBits(bits)
+	}
+
+	rejected := len(d.rejected)
+	for range reader.Bits() {
+		bit, _ := reader.ReadBit()
+		d.push(bit)
+	}
+
+	rvOut := reflect.ValueOf(d.outputPtr).Elem()
+	rvOut.Set(reflect.ValueOf(d.writer.AnyData()))
+	if len(d.rejected) > rejected {
+		return newDecodeError(d.rejected[rejected:len(d.rejected):len(d.rejected)], 23, ErrRejected)
+	}
+	return nil
+}
+
+// push appends one bit to the pending block and decodes it once complete.
+func (d *ChunkDecoder[T]) push(bit bool) {
+	d.pending <<= 1
+	if bit {
+		d.pending |= 1
+	}
+	d.pendBits++
+	if d.pendBits == 23 {
+		d.emit(d.pending)
+		d.pending, d.pendBits = 0, 0
+	}
+}
+
+// emit decodes one codeword and writes its data bits to the output.
+func (d *ChunkDecoder[T]) emit(codeword uint32) {
+	b, ok := decodeBlock(d.blocks, codeword, d.hook, d.policy)
+	if !ok {
+		d.rejected = append(d.rejected, d.blocks)
+	}
+	// right 12 bits are data
+	d.writer.Write16(4, 12, b)
+	d.blocks++
+}
+
+// Bits returns the total number of decoded bits appended so far.
+// This accumulates across multiple Decode calls and does not include
+// elements that were already in the output slice at creation time.
+func (d *ChunkDecoder[T]) Bits() int {
+	return d.blocks * 12
+}
+
+// Pending returns the number of buffered bits that do not yet form a complete 23-bit block.
+func (d *ChunkDecoder[T]) Pending() int {
+	return d.pendBits
+}
@@ -0,0 +1,89 @@
+package golay
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/yyyoichi/bitstream-go"
+)
+
+func TestChunkDecoder(t *testing.T) {
+	t.Run("Append", func(t *testing.T) {
+		v := []uint16{0x1234}
+		dec := NewChunkDecoder(&v)
+		// 23bit codeword split into 10bit + 13bit chunks
+		cw := EncodeWord(0xABC) << 9
+		_ = dec.Decode([]uint32{cw}, 10)
+		if dec.Pending() != 10 {
+			t.Fatalf("ChunkDecoder.Pending() failed: got %d, want %d", dec.Pending(), 10)
+		}
+		if !slices.Equal(v, []uint16{0x1234}) {
+			t.Fatalf("ChunkDecoder.Decode failed: got %#x, want %#x", v, []uint16{0x1234})
+		}
+		_ = dec.Decode([]uint32{cw << 10}, 13)
+		if dec.Pending() != 0 {
+			t.Fatalf("ChunkDecoder.Pending() failed: got %d, want %d", dec.Pending(), 0)
+		}
+		if dec.Bits() != 12 {
+			t.Fatalf("ChunkDecoder.Bits() failed: got %d, want %d", dec.Bits(), 12)
+		}
+		if !slices.Equal(v, []uint16{0x1234, 0xABC0}) {
+			t.Fatalf("ChunkDecoder.Decode failed: got %#x, want %#x", v, []uint16{0x1234, 0xABC0})
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		for range 20 {
+			w := bitstream.NewBitWriter[uint8](0, 0)
+			l := rand.Intn(0x400) + 1
+			for range l {
+				w.Write8(0, 8, uint8(rand.Intn(256)))
+			}
+			var encoded []uint8
+			enc := NewEncoder(&encoded)
+			_ = enc.Encode(w.Data(), 0)
+
+			var want []uint8
+			_ = NewDecoder(encoded, enc.Bits()).Decode(&want)
+
+			// feed the encoded stream in random sized, unaligned chunks
+			var got []uint8
+			dec := NewChunkDecoder(&got)
+			dec.SetCorrectionHook(func(c Correction) {
+				t.Fatalf("unexpected correction at block %d", c.Block)
+			})
+			reader := bitstream.NewBitReader(encoded, 0, 0)
+			for reader.Pos() < enc.Bits() {
+				n := min(rand.Intn(100)+1, enc.Bits()-reader.Pos())
+				chunk := bitstream.NewBitWriter[uint8](0, 0)
+				for range n {
+					bit, _ := reader.ReadBit()
+					chunk.WriteBool(bit)
+				}
+				_ = dec.Decode(chunk.Data(), chunk.Bits())
+			}
+			if dec.Pending() != 0 {
+				t.Fatalf("ChunkDecoder.Pending() failed: got %d, want %d", dec.Pending(), 0)
+			}
+			if dec.Bits() != DecodedBits(enc.Bits()) {
+				t.Fatalf("ChunkDecoder.Bits() failed: got %d, want %d", dec.Bits(), DecodedBits(enc.Bits()))
+			}
+			if !slices.Equal(got, want) {
+				t.Fatalf("ChunkDecoder.Decode failed: output differs from Decoder")
+			}
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		var v []uint8
+		dec := NewChunkDecoder(&v)
+		if err := dec.Decode(nil, 0); err == nil {
+			t.Errorf("ChunkDecoder.Decode(nil) should fail")
+		}
+		if err := dec.Decode(1, 0); err == nil {
+			t.Errorf("ChunkDecoder.Decode(int) should fail")
+		}
+		if err := dec.Decode([]int{1}, 0); err == nil {
+			t.Errorf("ChunkDecoder.Decode([]int) should fail")
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/yyyoichi/golay"
+)
+
+// variants lists the supported code variants:
+// 23 for Golay(23,12) and 24 for extended Golay(24,12).
+var variants = []string{"23", "24"}
+
+// layouts lists the supported bit layouts: packed for a continuous bitstream
+// and word for one codeword per 3 bytes.
+var layouts = []string{"packed", "word"}
+
+// codec encodes and decodes bit buffers with one code variant and layout.
+type codec struct {
+	// variant is "23" for Golay(23,12) or "24" for extended Golay(24,12).
+	variant string
+	// layout is "packed" for a continuous MSB-first bitstream
+	// or "word" for one codeword per 3 big-endian bytes.
+	layout string
+}
+
+// stats summarizes a decode run.
+type stats struct {
+	Blocks        int `json:"blocks"`
+	Corrected     int `json:"corrected_blocks"`
+	CorrectedBits int `json:"corrected_bits"`
+	Detected      int `json:"uncorrectable_blocks"`
+}
+
+func newCodec(variant, layout string) (codec, error) {
+	if !slices.Contains(variants, variant) {
+		return codec{}, fmt.Errorf("unknown variant %q (want one of %s)", variant, strings.Join(variants, ", "))
+	}
+	if !slices.Contains(layouts, layout) {
+		return codec{}, fmt.Errorf("unknown layout %q (want one of %s)", layout, strings.Join(layouts, ", "))
+	}
+	return codec{variant: variant, layout: layout}, nil
+}
+
+// n returns the codeword length in bits.
+func (c codec) n() int {
+	switch c.variant {
+	case "23":
+		return 23
+	case "24":
+		return 24
+	default:
+		return 0
+	}
+}
+
+// width returns the number of bits each codeword occupies in the layout.
+func (c codec) width() int {
+	if c.layout == "word" {
+		return 24
+	}
+	return c.n()
+}
+
+// encode splits in into 12-bit blocks and encodes each into a codeword.
+func (c codec) encode(in bitBuffer) bitBuffer {
+	if c.variant == "23" && c.layout == "packed" {
+		var out []uint8
+		enc := golay.NewEncoder(&out)
+		_ = enc.Encode(in.data, in.bits)
+		return bitBuffer{data: out, bits: enc.Bits()}
+	}
+	var out bitBuffer
+	for i := 0; i < in.bits; i += 12 {
+		d := uint16(in.word(i, 12))
+		var cw uint32
+		if c.variant == "24" {
+			cw = golay.EncodeExtended(d)
+		} else {
+			cw = golay.EncodeWord(d)
+		}
+		for k := c.width() - 1; k >= 0; k-- {
+			out.append(cw&(1<<k) != 0)
+		}
+	}
+	return out
+}
+
+// paddingTrailer returns the 12-bit data block of the trailer codeword for an input of n bits:
+// the number of zero bits that pad its last block.
+func paddingTrailer(n int) bitBuffer {
+	var buf bitBuffer
+	pad := (12 - n%12) % 12
+	for k := 11; k >= 0; k-- {
+		buf.append(pad&(1<<k) != 0)
+	}
+	return buf
+}
+
+// dropTrailer removes the decoded trailer block from the end of buf together with
+// the padding it records.
+func (buf *bitBuffer) dropTrailer() error {
+	if buf.bits < 12 {
+		return errors.New("missing length trailer (use -trailer=false for streams without one)")
+	}
+	pad := int(buf.word(buf.bits-12, 12))
+	n := buf.bits - 12 - pad
+	if pad > 11 || n < 0 {
+		return fmt.Errorf("invalid length trailer %d (use -trailer=false for streams without one)", pad)
+	}
+	if n == 0 {
+		*buf = bitBuffer{}
+		return nil
+	}
+	buf.truncate(n)
+	return nil
+}
+
+// decode splits in into codewords and decodes each into 12-bit data.
+// Any remainder shorter than a codeword is ignored.
+func (c codec) decode(in bitBuffer) (bitBuffer, stats) {
+	var st stats
+	if c.variant == "23" && c.layout == "packed" {
+		var out []uint8
+		dec := golay.NewDecoder(in.data, in.bits)
+		dec.SetCorrectionHook(func(corr golay.Correction) {
+			st.Corrected++
+			st.CorrectedBits += corr.Weight()
+		})
+		_ = dec.Decode(&out)
+		st.Blocks = in.bits / 23
+		return bitBuffer{data: out, bits: dec.Bits()}, st
+	}
+	var out bitBuffer
+	for i := 0; i+c.width() <= in.bits; i += c.width() {
+		cw := in.word(i, c.width())
+		st.Blocks++
+		d, ok, flipped := c.decodeWord(cw)
+		switch {
+		case !ok:
+			st.Detected++
+		case flipped > 0:
+			st.Corrected++
+			st.CorrectedBits += flipped
+		}
+		for k := 11; k >= 0; k-- {
+			out.append(d&(1<<k) != 0)
+		}
+	}
+	return out, st
+}
+
+// decodeWord decodes one codeword of the variant. ok is false if an uncorrectable
+// error was detected, and flipped is the number of corrected bits.
+func (c codec) decodeWord(cw uint32) (data uint16, ok bool, flipped int) {
+	if c.variant == "24" {
+		cw &= 0xFFFFFF
+		data, ok = golay.DecodeExtended(cw)
+		if !ok {
+			return data, false, 0
+		}
+		return data, true, bits.OnesCount32(cw ^ golay.EncodeExtended(data))
+	}
+	cw &= 0x7FFFFF
+	data = golay.Decode(cw)
+	return data, true, bits.OnesCount32(cw ^ golay.EncodeWord(data))
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// bitBuffer is an MSB-aligned bit sequence.
+type bitBuffer struct {
+	data []uint8
+	bits int
+}
+
+// formats lists the supported input and output formats.
+var formats = []string{"raw", "hex", "bits", "base64"}
+
+// readInput reads all of r and parses it according to format.
+func readInput(r io.Reader, format string) (bitBuffer, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return bitBuffer{}, err
+	}
+	switch format {
+	case "raw":
+		return bitBuffer{data: b, bits: len(b) * 8}, nil
+	case "hex":
+		data, err := hex.DecodeString(stripSpace(string(b)))
+		if err != nil {
+			return bitBuffer{}, fmt.Errorf("invalid hex input: %w", err)
+		}
+		return bitBuffer{data: data, bits: len(data) * 8}, nil
+	case "base64":
+		data, err := base64.StdEncoding.DecodeString(stripSpace(string(b)))
+		if err != nil {
+			return bitBuffer{}, fmt.Errorf("invalid base64 input: %w", err)
+		}
+		return bitBuffer{data: data, bits: len(data) * 8}, nil
+	case "bits":
+		var buf bitBuffer
+		for _, c := range stripSpace(string(b)) {
+			switch c {
+			case '0', '1':
+				buf.append(c == '1')
+			default:
+				return bitBuffer{}, fmt.Errorf("invalid bit string input: unexpected character %q", c)
+			}
+		}
+		return buf, nil
+	default:
+		return bitBuffer{}, fmt.Errorf("unknown format %q (want one of %s)", format, strings.Join(formats, ", "))
+	}
+}
+
+// writeOutput writes buf to w according to format.
+// Formats other than bits pad the last byte with zero bits.
+func writeOutput(w io.Writer, buf bitBuffer, format string) error {
+	data := buf.data[:(buf.bits+7)/8]
+	var err error
+	switch format {
+	case "raw":
+		_, err = w.Write(data)
+	case "hex":
+		_, err = fmt.Fprintln(w, hex.EncodeToString(data))
+	case "base64":
+		_, err = fmt.Fprintln(w, base64.StdEncoding.EncodeToString(data))
+	case "bits":
+		var sb strings.Builder
+		for i := range buf.bits {
+			if buf.bit(i) {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+		}
+		sb.WriteByte('\n')
+		_, err = io.WriteString(w, sb.String())
+	default:
+		err = fmt.Errorf("unknown format %q (want one of %s)", format, strings.Join(formats, ", "))
+	}
+	return err
+}
+
+// append adds one bit to the end of buf.
+func (buf *bitBuffer) append(bit bool) {
+	if buf.bits%8 == 0 {
+		buf.data = append(buf.data[:buf.bits/8], 0)
+	}
+	if bit {
+		buf.data[buf.bits/8] |= 1 << (7 - buf.bits%8)
+	}
+	buf.bits++
+}
+
+// appendBuffer adds the bits of o to the end of buf.
+func (buf *bitBuffer) appendBuffer(o bitBuffer) {
+	for i := range o.bits {
+		buf.append(o.bit(i))
+	}
+}
+
+// bit returns the i-th bit of buf.
+func (buf *bitBuffer) bit(i int) bool {
+	return buf.data[i/8]&(1<<(7-i%8)) != 0
+}
+
+// word returns n bits (up to 32) of buf starting at bit pos, right-aligned.
+func (buf *bitBuffer) word(pos, n int) uint32 {
+	var w uint32
+	for i := pos; i < pos+n; i++ {
+		w <<= 1
+		if i < buf.bits && buf.bit(i) {
+			w |= 1
+		}
+	}
+	return w
+}
+
+// truncate limits buf to n bits if n is positive and smaller than its length.
+func (buf *bitBuffer) truncate(n int) {
+	if n <= 0 || n >= buf.bits {
+		return
+	}
+	buf.bits = n
+	buf.data = buf.data[:(n+7)/8]
+	if n%8 != 0 {
+		buf.data[n/8] &= 0xFF << (8 - n%8)
+	}
+}
+
+func stripSpace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
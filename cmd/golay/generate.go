@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/yyyoichi/golay/codegen"
+)
+
+// generators maps target languages to their code generators.
+var generators = map[string]func(w io.Writer, name string) error{
+	"c":       codegen.WriteCHeader,
+	"verilog": codegen.WriteVerilog,
+	"vhdl":    codegen.WriteVHDL,
+}
+
+func runGenerate(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	lang := fs.String("lang", "c", "target language: c, verilog or vhdl")
+	name := fs.String("name", "golay", "prefix of the generated identifiers")
+	output := fs.String("o", "", "output file (default standard output)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("too many arguments")
+	}
+	generate, ok := generators[*lang]
+	if !ok {
+		return fmt.Errorf("unknown language %q (want c, verilog or vhdl)", *lang)
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Join(err, file.Close()) }()
+		w = file
+	}
+	return generate(w, *name)
+}
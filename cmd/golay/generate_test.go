@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		lang, want string
+	}{
+		{"c", "static inline uint16_t fec_correct(uint32_t word)"},
+		{"verilog", "module fec_decoder ("},
+		{"vhdl", "entity fec_decoder is"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run([]string{"generate", "-lang", tt.lang, "-name", "fec"}, nil, &stdout, &stderr); code != 0 {
+				t.Fatalf("generate failed: %s", stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.want) {
+				t.Errorf("generate failed: output does not contain %q", tt.want)
+			}
+		})
+	}
+	t.Run("Errors", func(t *testing.T) {
+		for _, args := range [][]string{{"-lang", "rust"}, {"-name", "1x"}, {"extra"}} {
+			var stdout, stderr bytes.Buffer
+			if code := run(append([]string{"generate"}, args...), nil, &stdout, &stderr); code != 1 {
+				t.Errorf("generate %v failed: got exit code %d, want %d", args, code, 1)
+			}
+		}
+	})
+}
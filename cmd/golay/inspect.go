@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/yyyoichi/golay"
+)
+
+// blockReport describes one received codeword.
+type blockReport struct {
+	index     int
+	offset    int
+	codeword  golay.Codeword
+	corrected uint16
+	fix       golay.Correction
+}
+
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("in", "raw", "input format: "+strings.Join(formats, ", "))
+	bits := fs.Int("bits", 0, "number of valid input bits (default all)")
+	region := fs.Int("region", 16, "number of blocks per region in the damage map")
+	summary := fs.Bool("summary", false, "print only the summary, histogram and damage map")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *region <= 0 {
+		return errors.New("region must be positive")
+	}
+	r := stdin
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		file, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Join(err, file.Close()) }()
+		r = file
+	default:
+		return errors.New("too many arguments")
+	}
+
+	in, err := readInput(r, *format)
+	if err != nil {
+		return err
+	}
+	in.truncate(*bits)
+	reports := inspect(in)
+
+	if !*summary {
+		printBlocks(stdout, reports)
+		fmt.Fprintln(stdout)
+	}
+	printSummary(stdout, reports, in.bits)
+	fmt.Fprintln(stdout)
+	printDamageMap(stdout, reports, *region)
+	return nil
+}
+
+// inspect decodes every 23-bit block of in and records its corrections.
+func inspect(in bitBuffer) []blockReport {
+	reports := make([]blockReport, in.bits/23)
+	for i := range reports {
+		cw := golay.Codeword(in.word(i*23, 23))
+		reports[i] = blockReport{index: i, offset: i * 23, codeword: cw}
+	}
+
+	var decoded []uint8
+	dec := golay.NewDecoder(in.data, in.bits)
+	dec.SetCorrectionHook(func(c golay.Correction) {
+		reports[c.Block].fix = c
+	})
+	_ = dec.Decode(&decoded)
+	out := bitBuffer{data: decoded, bits: dec.Bits()}
+	for i := range reports {
+		reports[i].corrected = uint16(out.word(i*12, 12))
+	}
+	return reports
+}
+
+func printBlocks(w io.Writer, reports []blockReport) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "block\toffset\tdata\tparity\tsyndrome\tweight\tcorrected\tpositions")
+	for _, r := range reports {
+		positions := make([]string, 0, 3)
+		for _, p := range r.fix.Positions() {
+			positions = append(positions, fmt.Sprint(p))
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%03x\t%03x\t%03x\t%d\t%03x\t%s\n",
+			r.index, r.offset, r.codeword.Data(), r.codeword.Parity(), r.codeword.Syndrome(),
+			r.fix.Weight(), r.corrected, strings.Join(positions, ","))
+	}
+	_ = tw.Flush()
+}
+
+func printSummary(w io.Writer, reports []blockReport, bits int) {
+	var histogram [4]int
+	var data, parity int
+	for _, r := range reports {
+		histogram[r.fix.Weight()]++
+		for _, p := range r.fix.Positions() {
+			if p < 12 {
+				data++
+			} else {
+				parity++
+			}
+		}
+	}
+	corrected := len(reports) - histogram[0]
+	fmt.Fprintf(w, "blocks: %d (%d bits, %d trailing bits ignored)\n", len(reports), bits, bits-len(reports)*23)
+	fmt.Fprintf(w, "corrected blocks: %d, corrected bits: %d (%d data, %d parity)\n", corrected, data+parity, data, parity)
+	fmt.Fprintln(w, "correction weight histogram:")
+	for weight, n := range histogram {
+		bar := 0
+		if len(reports) > 0 {
+			bar = (n*50 + len(reports) - 1) / len(reports)
+		}
+		fmt.Fprintf(w, "  %d: %*d %s\n", weight, len(fmt.Sprint(len(reports))), n, strings.Repeat("#", bar))
+	}
+}
+
+// printDamageMap prints one character per region of blocks:
+// '.' if no block in the region was corrected, otherwise the largest correction weight.
+func printDamageMap(w io.Writer, reports []blockReport, region int) {
+	fmt.Fprintf(w, "damage map (%d blocks per region, '.' = clean, 1-3 = largest correction weight):\n", region)
+	for start := 0; start < len(reports); start += region * 64 {
+		var line strings.Builder
+		for r := start; r < min(start+region*64, len(reports)); r += region {
+			worst := 0
+			for _, b := range reports[r:min(r+region, len(reports))] {
+				worst = max(worst, b.fix.Weight())
+			}
+			if worst == 0 {
+				line.WriteByte('.')
+			} else {
+				line.WriteByte(byte('0' + worst))
+			}
+		}
+		fmt.Fprintf(w, "  %8d %s\n", start, line.String())
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	// 4 blocks
+	var encoded, stderr bytes.Buffer
+	if code := run([]string{"encode", "-in", "hex", "-out", "bits", "-trailer=false"}, strings.NewReader("ABC123456789"), &encoded, &stderr); code != 0 {
+		t.Fatalf("encode failed: %s", stderr.String())
+	}
+	bits := []byte(strings.TrimSpace(encoded.String()))
+	// block 1: data bit 0 and parity bit 22, block 3: data bits 2, 3, 4
+	for _, i := range []int{23, 45, 71, 72, 73} {
+		bits[i] ^= 1
+	}
+
+	var out bytes.Buffer
+	if code := run([]string{"inspect", "-in", "bits", "-region", "2"}, bytes.NewReader(bits), &out, &stderr); code != 0 {
+		t.Fatalf("inspect failed: %s", stderr.String())
+	}
+	lines := strings.Split(out.String(), "\n")
+	for _, tt := range []struct {
+		line   int
+		fields []string
+	}{
+		{0, []string{"block", "offset", "data", "parity", "syndrome", "weight", "corrected", "positions"}},
+		{1, []string{"0", "0", "abc", "", "000", "0", "abc"}},
+		{2, []string{"1", "23", "923", "", "", "2", "123", "0,22"}},
+		{3, []string{"2", "46", "456", "", "000", "0", "456"}},
+		{4, []string{"3", "69", "409", "", "", "3", "789", "2,3,4"}},
+	} {
+		fields := strings.Fields(lines[tt.line])
+		for i, want := range tt.fields {
+			if want != "" && (i >= len(fields) || fields[i] != want) {
+				t.Errorf("line %d field %d failed: got %q, want %q", tt.line, i, lines[tt.line], want)
+			}
+		}
+	}
+	for _, want := range []string{
+		"blocks: 4 (92 bits, 0 trailing bits ignored)",
+		"corrected blocks: 2, corrected bits: 5 (4 data, 1 parity)",
+		"  0: 2 ",
+		"  2: 1 ",
+		"  3: 1 ",
+		"         0 23",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("inspect output does not contain %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	_ = run([]string{"inspect", "-in", "bits", "-summary"}, bytes.NewReader(bits), &out, &stderr)
+	if strings.Contains(out.String(), "offset") {
+		t.Errorf("inspect -summary printed the block table:\n%s", out.String())
+	}
+}
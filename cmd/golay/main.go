@@ -0,0 +1,206 @@
+// Command golay encodes and decodes files with the Golay(23,12) error-correcting code.
+//
+// Usage:
+//
+//	golay encode [flags] [file]
+//	golay decode [flags] [file]
+//	golay inspect [flags] [file]
+//	golay simulate [flags]
+//	golay serve [flags]
+//	golay generate [flags]
+//
+// Input is read from file, or from standard input if no file is given.
+// Run "golay <command> -h" for the flags of each command.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// commands maps subcommand names to their implementations.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) error{
+	"encode":   runEncode,
+	"decode":   runDecode,
+	"inspect":  runInspect,
+	"simulate": runSimulate,
+	"serve":    runServe,
+	"generate": runGenerate,
+}
+
+// run executes the command line args and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		if args[0] != "-h" && args[0] != "help" {
+			fmt.Fprintf(stderr, "golay: unknown command %q\n", args[0])
+		}
+		usage(stderr)
+		return 2
+	}
+	if err := cmd(args[1:], stdin, stdout, stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "golay %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: golay <command> [flags] [file]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	fmt.Fprintln(w, "  encode    encode data into Golay codewords")
+	fmt.Fprintln(w, "  decode    decode Golay codewords with error correction")
+	fmt.Fprintln(w, "  inspect   print per-block diagnostics of an encoded stream")
+	fmt.Fprintln(w, "  simulate  measure error rates over simulated channels")
+	fmt.Fprintln(w, "  serve     run an HTTP encode/decode service")
+	fmt.Fprintln(w, "  generate  generate C headers and Verilog/VHDL modules")
+}
+
+// codecFlags holds the flags shared by encode and decode.
+type codecFlags struct {
+	in, out string
+	output  string
+	variant string
+	layout  string
+	bits    int
+	trailer bool
+}
+
+func (f *codecFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.in, "in", "raw", "input format: "+strings.Join(formats, ", "))
+	fs.StringVar(&f.out, "out", "raw", "output format: "+strings.Join(formats, ", "))
+	fs.StringVar(&f.output, "o", "", "output file (default standard output)")
+	fs.StringVar(&f.variant, "variant", "23", "code variant: "+strings.Join(variants, ", ")+" (Golay(23,12) or extended Golay(24,12))")
+	fs.StringVar(&f.layout, "layout", "packed", "bit layout: "+strings.Join(layouts, ", ")+" (continuous bitstream or one codeword per 3 bytes)")
+	fs.IntVar(&f.bits, "bits", 0, "number of valid input bits (default all)")
+	fs.BoolVar(&f.trailer, "trailer", true, "end the encoded stream with a codeword holding the padding length, so decode restores the exact input length")
+}
+
+// open returns the input reader, output writer and codec for the parsed flags.
+// The returned function closes any opened files.
+func (f *codecFlags) open(fs *flag.FlagSet, stdin io.Reader, stdout io.Writer) (io.Reader, io.Writer, codec, func() error, error) {
+	c, err := newCodec(f.variant, f.layout)
+	if err != nil {
+		return nil, nil, codec{}, nil, err
+	}
+	var closers []io.Closer
+	closeAll := func() error {
+		var errs []error
+		for _, c := range closers {
+			errs = append(errs, c.Close())
+		}
+		return errors.Join(errs...)
+	}
+	r := stdin
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		file, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return nil, nil, codec{}, nil, err
+		}
+		closers = append(closers, file)
+		r = file
+	default:
+		return nil, nil, codec{}, nil, errors.New("too many arguments")
+	}
+	w := stdout
+	if f.output != "" {
+		file, err := os.Create(f.output)
+		if err != nil {
+			_ = closeAll()
+			return nil, nil, codec{}, nil, err
+		}
+		closers = append(closers, file)
+		w = file
+	}
+	return r, w, c, closeAll, nil
+}
+
+func runEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet("encode", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var f codecFlags
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	r, w, c, closeAll, err := f.open(fs, stdin, stdout)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeAll()) }()
+
+	in, err := readInput(r, f.in)
+	if err != nil {
+		return err
+	}
+	in.truncate(f.bits)
+	out := c.encode(in)
+	if f.trailer {
+		out.appendBuffer(c.encode(paddingTrailer(in.bits)))
+	}
+	return writeOutput(w, out, f.out)
+}
+
+func runDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet("decode", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var f codecFlags
+	f.register(fs)
+	outBits := fs.Int("outbits", 0, "truncate the decoded output to this many bits (default all, or the input length recorded by the trailer)")
+	statsFormat := fs.String("stats", "text", "decode statistics written to standard error: text, json or none")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *statsFormat != "text" && *statsFormat != "json" && *statsFormat != "none" {
+		return fmt.Errorf("unknown stats format %q (want text, json or none)", *statsFormat)
+	}
+	r, w, c, closeAll, err := f.open(fs, stdin, stdout)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, closeAll()) }()
+
+	in, err := readInput(r, f.in)
+	if err != nil {
+		return err
+	}
+	in.truncate(f.bits)
+	out, st := c.decode(in)
+	if f.trailer {
+		if err := out.dropTrailer(); err != nil {
+			return err
+		}
+	}
+	out.truncate(*outBits)
+	if err := writeOutput(w, out, f.out); err != nil {
+		return err
+	}
+
+	switch *statsFormat {
+	case "text":
+		fmt.Fprintf(stderr, "blocks: %d, corrected blocks: %d, corrected bits: %d, uncorrectable blocks: %d\n",
+			st.Blocks, st.Corrected, st.CorrectedBits, st.Detected)
+	case "json":
+		return json.NewEncoder(stderr).Encode(st)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	data := []byte("Golay(23,12) command line")
+	for _, variant := range variants {
+		for _, layout := range layouts {
+			for _, format := range formats {
+				var encoded, decoded, stderr bytes.Buffer
+				code := run([]string{"encode", "-variant", variant, "-layout", layout, "-out", format},
+					bytes.NewReader(data), &encoded, &stderr)
+				if code != 0 {
+					t.Fatalf("encode %s/%s/%s failed: %s", variant, layout, format, stderr.String())
+				}
+				code = run([]string{"decode", "-variant", variant, "-layout", layout, "-in", format, "-stats", "none"},
+					&encoded, &decoded, &stderr)
+				if code != 0 {
+					t.Fatalf("decode %s/%s/%s failed: %s", variant, layout, format, stderr.String())
+				}
+				if !bytes.Equal(decoded.Bytes(), data) {
+					t.Errorf("round trip %s/%s/%s failed: got %q, want %q", variant, layout, format, decoded.Bytes(), data)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeStats(t *testing.T) {
+	// 2 blocks of 12 bits and the trailer
+	var encoded, stderr bytes.Buffer
+	if code := run([]string{"encode", "-in", "hex", "-out", "bits"}, strings.NewReader("ABC123"), &encoded, &stderr); code != 0 {
+		t.Fatalf("encode failed: %s", stderr.String())
+	}
+	bits := []byte(strings.TrimSpace(encoded.String()))
+	if len(bits) != 69 {
+		t.Fatalf("encode failed: got %d bits, want %d", len(bits), 69)
+	}
+	// 2 errors in block 0, 1 error in block 1
+	for _, i := range []int{0, 22, 30} {
+		bits[i] ^= 1
+	}
+
+	var decoded bytes.Buffer
+	stderr.Reset()
+	if code := run([]string{"decode", "-in", "bits", "-out", "hex", "-stats", "json"}, bytes.NewReader(bits), &decoded, &stderr); code != 0 {
+		t.Fatalf("decode failed: %s", stderr.String())
+	}
+	if got := strings.TrimSpace(decoded.String()); got != "abc123" {
+		t.Errorf("decode failed: got %q, want %q", got, "abc123")
+	}
+	var st stats
+	if err := json.Unmarshal(stderr.Bytes(), &st); err != nil {
+		t.Fatalf("invalid stats %q: %v", stderr.String(), err)
+	}
+	if st != (stats{Blocks: 3, Corrected: 2, CorrectedBits: 3}) {
+		t.Errorf("decode stats failed: got %+v", st)
+	}
+}
+
+func TestDecodeExtendedDetection(t *testing.T) {
+	var encoded, stderr bytes.Buffer
+	_ = run([]string{"encode", "-variant", "24", "-in", "hex", "-out", "bits", "-bits", "12"}, strings.NewReader("ABC0"), &encoded, &stderr)
+	bits := []byte(strings.TrimSpace(encoded.String()))
+	for _, i := range []int{0, 5, 10, 15} {
+		bits[i] ^= 1
+	}
+	var decoded bytes.Buffer
+	_ = run([]string{"decode", "-variant", "24", "-in", "bits", "-stats", "json"}, bytes.NewReader(bits), &decoded, &stderr)
+	var st stats
+	_ = json.Unmarshal(stderr.Bytes(), &st)
+	if st != (stats{Blocks: 2, Detected: 1}) {
+		t.Errorf("decode stats failed: got %+v", st)
+	}
+}
+
+func TestTrailer(t *testing.T) {
+	roundTrip := func(data []byte, encodeArgs, decodeArgs []string) ([]byte, string, int) {
+		var encoded, decoded, stderr bytes.Buffer
+		if code := run(append([]string{"encode"}, encodeArgs...), bytes.NewReader(data), &encoded, &stderr); code != 0 {
+			t.Fatalf("encode failed: %s", stderr.String())
+		}
+		args := append([]string{"decode", "-stats", "none"}, decodeArgs...)
+		code := run(args, &encoded, &decoded, &stderr)
+		return decoded.Bytes(), stderr.String(), code
+	}
+	// every input length is restored without -outbits
+	for n := range 7 {
+		data := []byte("trailer")[:n]
+		if got, msg, code := roundTrip(data, nil, nil); code != 0 || !bytes.Equal(got, data) {
+			t.Errorf("round trip of %d bytes failed: got (%q, %q), want %q", n, got, msg, data)
+		}
+	}
+	// without the trailer the padding of the last block is decoded
+	if got, _, _ := roundTrip([]byte("Go"), []string{"-trailer=false"}, []string{"-trailer=false"}); !bytes.Equal(got, []byte("Go\x00")) {
+		t.Errorf("round trip without trailer failed: got %q, want %q", got, "Go\x00")
+	}
+	// a stream without a trailer is rejected
+	if _, msg, code := roundTrip([]byte{0xFF, 0xFF, 0xFF}, []string{"-trailer=false"}, nil); code == 0 || !strings.Contains(msg, "trailer") {
+		t.Errorf("decode without trailer failed: got (%d, %q), want an invalid trailer error", code, msg)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "in.bin")
+	enc := filepath.Join(dir, "enc.bin")
+	dec := filepath.Join(dir, "dec.bin")
+	if err := os.WriteFile(in, []byte{0xDE, 0xAD, 0xBE}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"encode", "-o", enc, in}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("encode failed: %s", stderr.String())
+	}
+	if code := run([]string{"decode", "-stats", "none", "-o", dec, enc}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("decode failed: %s", stderr.String())
+	}
+	got, _ := os.ReadFile(dec)
+	if !bytes.Equal(got, []byte{0xDE, 0xAD, 0xBE}) {
+		t.Errorf("decode file failed: got %#x", got)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("unexpected standard output %q", stdout.String())
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"unknown"},
+		{"encode", "-variant", "25"},
+		{"encode", "-layout", "lsb"},
+		{"encode", "-in", "octal"},
+		{"decode", "-in", "hex"},
+		{"decode", "-in", "bits"},
+		{"decode", "-stats", "xml"},
+		{"encode", "a", "b"},
+		{"encode", filepath.Join(t.TempDir(), "missing")},
+	} {
+		var stdout, stderr bytes.Buffer
+		stdin := strings.NewReader("xyz")
+		if code := run(args, stdin, &stdout, &stderr); code == 0 {
+			t.Errorf("run(%q) should fail", args)
+		}
+		if stderr.Len() == 0 {
+			t.Errorf("run(%q) wrote no error message", args)
+		}
+	}
+}
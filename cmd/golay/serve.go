@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxBodyBytes limits the size of request bodies.
+const maxBodyBytes = 64 << 20
+
+// Server timeouts, so slow clients cannot hold connections open indefinitely.
+const (
+	readHeaderTimeout = 10 * time.Second
+	readTimeout       = time.Minute
+)
+
+// jsonRequest is the body of a JSON encode or decode request.
+// Fields other than Data override the query parameters.
+type jsonRequest struct {
+	Data    []byte `json:"data"`
+	Bits    int    `json:"bits,omitempty"`
+	Variant string `json:"variant,omitempty"`
+	Layout  string `json:"layout,omitempty"`
+}
+
+// jsonResponse is the body of a JSON encode or decode response.
+type jsonResponse struct {
+	Data  []byte `json:"data"`
+	Bits  int    `json:"bits"`
+	Stats *stats `json:"stats,omitempty"`
+}
+
+func runServe(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", "localhost:8023", "listen address")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("too many arguments")
+	}
+	fmt.Fprintf(stderr, "golay serve: listening on %s\n", *addr)
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+	}
+	return srv.ListenAndServe()
+}
+
+// newHandler returns the HTTP handler of the encode/decode service.
+//
+//	POST /encode  encodes the request body
+//	POST /decode  decodes the request body
+//
+// Raw bodies are processed as binary data and answered with binary data;
+// the parameters variant, layout and bits are taken from the query string,
+// and the bit length and decode statistics are returned in X-Golay-* headers.
+// Bodies with Content-Type application/json are parsed as jsonRequest and
+// answered with jsonResponse, where data is base64 encoded.
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /encode", func(w http.ResponseWriter, r *http.Request) {
+		serveCodec(w, r, false)
+	})
+	mux.HandleFunc("POST /decode", func(w http.ResponseWriter, r *http.Request) {
+		serveCodec(w, r, true)
+	})
+	return mux
+}
+
+func serveCodec(w http.ResponseWriter, r *http.Request, decode bool) {
+	q := r.URL.Query()
+	req := jsonRequest{Variant: q.Get("variant"), Layout: q.Get("layout")}
+	if s := q.Get("bits"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("invalid bits %q", s), http.StatusBadRequest)
+			return
+		}
+		req.Bits = n
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.As(err, new(*http.MaxBytesError)) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	isJSON := mediaType == "application/json"
+	if isJSON {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			http.Error(w, "invalid JSON request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Bits < 0 {
+			http.Error(w, fmt.Sprintf("invalid bits %d", req.Bits), http.StatusBadRequest)
+			return
+		}
+	} else {
+		req.Data = body
+	}
+	if req.Variant == "" {
+		req.Variant = "23"
+	}
+	if req.Layout == "" {
+		req.Layout = "packed"
+	}
+
+	c, err := newCodec(req.Variant, req.Layout)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	in := bitBuffer{data: req.Data, bits: len(req.Data) * 8}
+	in.truncate(req.Bits)
+
+	var out bitBuffer
+	var st *stats
+	if decode {
+		var s stats
+		out, s = c.decode(in)
+		st = &s
+	} else {
+		out = c.encode(in)
+	}
+	data := out.data[:(out.bits+7)/8]
+
+	if isJSON {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(jsonResponse{Data: data, Bits: out.bits, Stats: st})
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/octet-stream")
+	h.Set("X-Golay-Bits", strconv.Itoa(out.bits))
+	if st != nil {
+		h.Set("X-Golay-Blocks", strconv.Itoa(st.Blocks))
+		h.Set("X-Golay-Corrected-Blocks", strconv.Itoa(st.Corrected))
+		h.Set("X-Golay-Corrected-Bits", strconv.Itoa(st.CorrectedBits))
+		h.Set("X-Golay-Uncorrectable-Blocks", strconv.Itoa(st.Detected))
+	}
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+)
+
+// zeroReader is an endless stream of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestServe(t *testing.T) {
+	srv := httptest.NewServer(newHandler())
+	defer srv.Close()
+	data := []byte("serve golay")
+
+	t.Run("Raw", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+"/encode?variant=24&layout=word", "application/octet-stream", bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		encoded, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		// 88 bits -> 8 blocks of 24 bits
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Golay-Bits") != "192" || len(encoded) != 24 {
+			t.Fatalf("encode failed: %s %q, %d bytes", resp.Status, resp.Header.Get("X-Golay-Bits"), len(encoded))
+		}
+		encoded[0] ^= 0x81
+		encoded[5] ^= 0x01
+
+		resp, err = http.Post(srv.URL+"/decode?variant=24&layout=word", "application/octet-stream", bytes.NewReader(encoded))
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !bytes.Equal(decoded[:len(data)], data) {
+			t.Errorf("decode failed: got %q, want %q", decoded, data)
+		}
+		for header, want := range map[string]string{
+			"X-Golay-Bits":                 "96",
+			"X-Golay-Blocks":               "8",
+			"X-Golay-Corrected-Blocks":     "2",
+			"X-Golay-Corrected-Bits":       "3",
+			"X-Golay-Uncorrectable-Blocks": "0",
+		} {
+			if got := resp.Header.Get(header); got != want {
+				t.Errorf("decode header %s failed: got %q, want %q", header, got, want)
+			}
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		post := func(path string, req jsonRequest) jsonResponse {
+			t.Helper()
+			body, _ := json.Marshal(req)
+			resp, err := http.Post(srv.URL+path, "application/json", bytes.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("POST %s failed: %s", path, resp.Status)
+			}
+			var out jsonResponse
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatal(err)
+			}
+			return out
+		}
+		enc := post("/encode", jsonRequest{Data: data, Bits: 84})
+		if enc.Bits != 161 || enc.Stats != nil {
+			t.Fatalf("encode failed: got %+v", enc)
+		}
+		enc.Data[2] ^= 0x10
+		dec := post("/decode?variant=23", jsonRequest{Data: enc.Data, Bits: enc.Bits})
+		if dec.Bits != 84 || !bytes.Equal(dec.Data[:10], data[:10]) {
+			t.Errorf("decode failed: got %+v", dec)
+		}
+		if dec.Stats == nil || *dec.Stats != (stats{Blocks: 7, Corrected: 1, CorrectedBits: 1}) {
+			t.Errorf("decode stats failed: got %+v", dec.Stats)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			method, path, contentType, body string
+			status                          int
+		}{
+			{"POST", "/encode?variant=25", "application/octet-stream", "x", http.StatusBadRequest},
+			{"POST", "/encode?layout=lsb", "application/octet-stream", "x", http.StatusBadRequest},
+			{"POST", "/encode?bits=-1", "application/octet-stream", "x", http.StatusBadRequest},
+			{"POST", "/decode", "application/json", "{", http.StatusBadRequest},
+			{"POST", "/decode", "application/json", `{"unknown": 1}`, http.StatusBadRequest},
+			{"POST", "/encode", "application/json", `{"data": "eA==", "bits": -1}`, http.StatusBadRequest},
+			{"GET", "/encode", "", "", http.StatusMethodNotAllowed},
+			{"POST", "/inspect", "", "", http.StatusNotFound},
+		} {
+			req, _ := http.NewRequest(tt.method, srv.URL+tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Errorf("%s %s failed: got %s, want %d", tt.method, tt.path, resp.Status, tt.status)
+			}
+		}
+	})
+	t.Run("Body", func(t *testing.T) {
+		for _, tt := range []struct {
+			name   string
+			body   io.Reader
+			status int
+		}{
+			{"TooLarge", io.LimitReader(zeroReader{}, maxBodyBytes+1), http.StatusRequestEntityTooLarge},
+			{"ReadError", iotest.ErrReader(errors.New("connection reset")), http.StatusBadRequest},
+		} {
+			rec := httptest.NewRecorder()
+			newHandler().ServeHTTP(rec, httptest.NewRequest("POST", "/encode", tt.body))
+			if rec.Code != tt.status {
+				t.Errorf("%s failed: got %d, want %d", tt.name, rec.Code, tt.status)
+			}
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yyyoichi/golay/sim"
+)
+
+// simRow is one line of simulation output.
+type simRow struct {
+	Mode        string  `json:"mode"`
+	Channel     string  `json:"channel"`
+	X           float64 `json:"x"`
+	Blocks      int     `json:"blocks"`
+	Bits        int     `json:"bits"`
+	BitErrors   int     `json:"bit_errors"`
+	FrameErrors int     `json:"frame_errors"`
+	Detected    int     `json:"detected"`
+	BER         float64 `json:"ber"`
+	BERLow      float64 `json:"ber_low"`
+	BERHigh     float64 `json:"ber_high"`
+	FER         float64 `json:"fer"`
+	FERLow      float64 `json:"fer_low"`
+	FERHigh     float64 `json:"fer_high"`
+}
+
+var simColumns = []string{
+	"mode", "channel", "x", "blocks", "bits", "bit_errors", "frame_errors", "detected",
+	"ber", "ber_low", "ber_high", "fer", "fer_low", "fer_high",
+}
+
+func runSimulate(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	channel := fs.String("channel", "awgn", "channel: awgn (x is Eb/N0 in dB) or bsc (x is the crossover probability)")
+	from := fs.Float64("from", 0, "first x value of the sweep")
+	to := fs.Float64("to", 8, "last x value of the sweep")
+	step := fs.Float64("step", 1, "x increment of the sweep")
+	values := fs.String("values", "", "comma separated x values, overriding -from, -to and -step")
+	modes := fs.String("modes", "hard,soft,extended", "comma separated decoding modes: hard, soft, extended")
+	blocks := fs.Int("blocks", 100000, "number of 12-bit blocks per point")
+	seed := fs.Uint64("seed", 1, "random seed")
+	format := fs.String("format", "csv", "output format: csv or json")
+	output := fs.String("o", "", "output file (default standard output)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return errors.New("too many arguments")
+	}
+	if *channel != "awgn" && *channel != "bsc" {
+		return fmt.Errorf("unknown channel %q (want awgn or bsc)", *channel)
+	}
+	if *format != "csv" && *format != "json" {
+		return fmt.Errorf("unknown format %q (want csv or json)", *format)
+	}
+	if *blocks <= 0 {
+		return errors.New("blocks must be positive")
+	}
+	xs, err := sweepValues(*values, *from, *to, *step)
+	if err != nil {
+		return err
+	}
+	ms, err := parseModes(*modes)
+	if err != nil {
+		return err
+	}
+
+	var rows []simRow
+	for _, m := range ms {
+		cfg := sim.Config{Mode: m, Blocks: *blocks, Seed: *seed}
+		var points []sim.Point
+		if *channel == "awgn" {
+			points, err = sim.SweepEbN0(xs, cfg)
+		} else {
+			points, err = sim.SweepBSC(xs, cfg)
+		}
+		if err != nil {
+			return err
+		}
+		for i, p := range points {
+			r := p.Result
+			rows = append(rows, simRow{
+				Mode: m.String(), Channel: *channel, X: xs[i],
+				Blocks: r.Blocks, Bits: r.Bits, BitErrors: r.BitErrors, FrameErrors: r.FrameErrors, Detected: r.Detected,
+				BER: r.BER, BERLow: r.BERInterval.Low, BERHigh: r.BERInterval.High,
+				FER: r.FER, FERLow: r.FERInterval.Low, FERHigh: r.FERInterval.High,
+			})
+		}
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Join(err, file.Close()) }()
+		w = file
+	}
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write(simColumns)
+	for _, r := range rows {
+		f := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+		_ = cw.Write([]string{
+			r.Mode, r.Channel, f(r.X), strconv.Itoa(r.Blocks), strconv.Itoa(r.Bits),
+			strconv.Itoa(r.BitErrors), strconv.Itoa(r.FrameErrors), strconv.Itoa(r.Detected),
+			f(r.BER), f(r.BERLow), f(r.BERHigh), f(r.FER), f(r.FERLow), f(r.FERHigh),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// sweepValues returns the x values of a sweep, either parsed from a comma separated
+// list or generated from from to to (inclusive) in steps of step.
+func sweepValues(list string, from, to, step float64) ([]float64, error) {
+	if list != "" {
+		var xs []float64
+		for _, s := range strings.Split(list, ",") {
+			x, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q: %w", s, err)
+			}
+			xs = append(xs, x)
+		}
+		return xs, nil
+	}
+	if step <= 0 {
+		return nil, errors.New("step must be positive")
+	}
+	if to < from {
+		return nil, errors.New("to must not be less than from")
+	}
+	n := int((to-from)/step+1e-9) + 1
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = from + float64(i)*step
+	}
+	return xs, nil
+}
+
+// parseModes parses a comma separated list of decoding mode names.
+func parseModes(list string) ([]sim.Mode, error) {
+	var ms []sim.Mode
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		i := slices.IndexFunc(sim.Modes, func(m sim.Mode) bool { return m.String() == s })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown mode %q (want hard, soft or extended)", s)
+		}
+		ms = append(ms, sim.Modes[i])
+	}
+	return ms, nil
+}
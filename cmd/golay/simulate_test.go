@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Run("CSV", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		if code := run([]string{"simulate", "-from", "2", "-to", "4", "-step", "1", "-blocks", "500"}, nil, &stdout, &stderr); code != 0 {
+			t.Fatalf("simulate failed: %s", stderr.String())
+		}
+		records, err := csv.NewReader(&stdout).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid csv: %v", err)
+		}
+		// header + 3 modes x 3 points
+		if len(records) != 10 {
+			t.Fatalf("simulate failed: got %d records, want %d", len(records), 10)
+		}
+		if !slices.Equal(records[0], simColumns) {
+			t.Errorf("simulate header failed: got %v", records[0])
+		}
+		var modes, xs []string
+		for _, r := range records[1:] {
+			modes = append(modes, r[0])
+			xs = append(xs, r[2])
+		}
+		if !slices.Equal(modes, []string{"hard", "hard", "hard", "soft", "soft", "soft", "extended", "extended", "extended"}) {
+			t.Errorf("simulate modes failed: got %v", modes)
+		}
+		if !slices.Equal(xs, []string{"2", "3", "4", "2", "3", "4", "2", "3", "4"}) {
+			t.Errorf("simulate x values failed: got %v", xs)
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		args := []string{"simulate", "-channel", "bsc", "-values", "0,0.05", "-modes", "hard", "-blocks", "1000", "-format", "json"}
+		if code := run(args, nil, &stdout, &stderr); code != 0 {
+			t.Fatalf("simulate failed: %s", stderr.String())
+		}
+		var rows []simRow
+		if err := json.Unmarshal(stdout.Bytes(), &rows); err != nil {
+			t.Fatalf("invalid json: %v", err)
+		}
+		if len(rows) != 2 {
+			t.Fatalf("simulate failed: got %d rows, want %d", len(rows), 2)
+		}
+		if rows[0].BitErrors != 0 || rows[0].Blocks != 1000 || rows[0].Channel != "bsc" {
+			t.Errorf("simulate over a noiseless channel failed: got %+v", rows[0])
+		}
+		if rows[1].FrameErrors == 0 || rows[1].FER <= rows[0].FER {
+			t.Errorf("simulate at p=0.05 failed: got %+v", rows[1])
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"simulate", "-channel", "rayleigh"},
+			{"simulate", "-modes", "hard,ml"},
+			{"simulate", "-format", "xml"},
+			{"simulate", "-step", "0"},
+			{"simulate", "-from", "5", "-to", "1"},
+			{"simulate", "-values", "1,x"},
+			{"simulate", "-blocks", "0"},
+			{"simulate", "extra"},
+		} {
+			var stdout, stderr bytes.Buffer
+			if code := run(args, nil, &stdout, &stderr); code == 0 {
+				t.Errorf("run(%q) should fail", args)
+			}
+		}
+	})
+}
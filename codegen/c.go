@@ -0,0 +1,88 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yyyoichi/golay"
+)
+
+// WriteCHeader writes a self-contained C99 header to w.
+// name prefixes every identifier: the header defines the tables NAME_G, NAME_H and
+// NAME_CORRECTIONS (upper case) and the static inline functions
+//
+//	uint16_t name_parity(uint16_t data);     /* 11-bit parity of 12-bit data */
+//	uint32_t name_codeword(uint16_t data);   /* 23-bit codeword of 12-bit data */
+//	uint16_t name_syndrome(uint32_t word);   /* 11-bit syndrome of a 23-bit word */
+//	uint16_t name_correct(uint32_t word);    /* 12-bit data corrected for up to 3 errors */
+//
+// which behave like golay.Encode, golay.EncodeWord, golay.Codeword.Syndrome and golay.Decode.
+func WriteCHeader(w io.Writer, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	lower, upper := strings.ToLower(name), strings.ToUpper(name)
+	b := bufio.NewWriter(w)
+
+	fmt.Fprintf(b, "/* %s */\n\n", Header)
+	fmt.Fprintf(b, "/*\n * Golay(23,12) tables and functions.\n")
+	fmt.Fprintf(b, " * Codewords are 23-bit values laid out as [data(12) | parity(11)], MSB first.\n */\n")
+	fmt.Fprintf(b, "#ifndef %s_TABLES_H\n#define %s_TABLES_H\n\n#include <stdint.h>\n\n", upper, upper)
+
+	fmt.Fprintf(b, "/* Generator matrix: parity bit 10-i is the parity of data & %s_G[i]. */\n", upper)
+	fmt.Fprintf(b, "static const uint16_t %s_G[11] = {\n", upper)
+	for _, row := range golay.GeneratorMatrix() {
+		fmt.Fprintf(b, "\t0x%03x,\n", row)
+	}
+	fmt.Fprintf(b, "};\n\n")
+
+	fmt.Fprintf(b, "/* Parity check matrix: syndrome bit 10-i is the parity of word & %s_H[i]. */\n", upper)
+	fmt.Fprintf(b, "static const uint32_t %s_H[11] = {\n", upper)
+	for _, row := range golay.ParityCheckMatrix() {
+		fmt.Fprintf(b, "\t0x%06x,\n", row)
+	}
+	fmt.Fprintf(b, "};\n\n")
+
+	fmt.Fprintf(b, "/* Coset leaders: the error pattern to XOR into a word, indexed by its syndrome. */\n")
+	fmt.Fprintf(b, "static const uint32_t %s_CORRECTIONS[2048] = {\n", upper)
+	leaders := golay.CosetLeaders()
+	for i := 0; i < len(leaders); i += 8 {
+		b.WriteString("\t")
+		for j, l := range leaders[i : i+8] {
+			if j > 0 {
+				b.WriteString(" ")
+			}
+			fmt.Fprintf(b, "0x%06x,", uint32(l))
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "};\n\n")
+
+	fmt.Fprintf(b, "static inline uint32_t %s_odd(uint32_t x)\n{\n", lower)
+	fmt.Fprintf(b, "\tx ^= x >> 16;\n\tx ^= x >> 8;\n\tx ^= x >> 4;\n\tx ^= x >> 2;\n\tx ^= x >> 1;\n\treturn x & 1;\n}\n\n")
+
+	fmt.Fprintf(b, "/* Returns the 11-bit parity of the 12-bit data. */\n")
+	fmt.Fprintf(b, "static inline uint16_t %s_parity(uint16_t data)\n{\n", lower)
+	fmt.Fprintf(b, "\tuint16_t parity = 0;\n\tint i;\n\n\tdata &= 0xfff;\n")
+	fmt.Fprintf(b, "\tfor (i = 0; i < 11; i++)\n\t\tparity |= (uint16_t)(%s_odd(data & %s_G[i]) << (10 - i));\n", lower, upper)
+	fmt.Fprintf(b, "\treturn parity;\n}\n\n")
+
+	fmt.Fprintf(b, "/* Returns the 23-bit codeword of the 12-bit data. */\n")
+	fmt.Fprintf(b, "static inline uint32_t %s_codeword(uint16_t data)\n{\n", lower)
+	fmt.Fprintf(b, "\tdata &= 0xfff;\n\treturn ((uint32_t)data << 11) | %s_parity(data);\n}\n\n", lower)
+
+	fmt.Fprintf(b, "/* Returns the 11-bit syndrome of the 23-bit word; 0 for a valid codeword. */\n")
+	fmt.Fprintf(b, "static inline uint16_t %s_syndrome(uint32_t word)\n{\n", lower)
+	fmt.Fprintf(b, "\tuint16_t syndrome = 0;\n\tint i;\n\n\tword &= 0x7fffff;\n")
+	fmt.Fprintf(b, "\tfor (i = 0; i < 11; i++)\n\t\tsyndrome |= (uint16_t)(%s_odd(word & %s_H[i]) << (10 - i));\n", lower, upper)
+	fmt.Fprintf(b, "\treturn syndrome;\n}\n\n")
+
+	fmt.Fprintf(b, "/* Returns the 12-bit data of the 23-bit word, correcting up to 3 bit errors. */\n")
+	fmt.Fprintf(b, "static inline uint16_t %s_correct(uint32_t word)\n{\n", lower)
+	fmt.Fprintf(b, "\tword &= 0x7fffff;\n\treturn (uint16_t)((word ^ %s_CORRECTIONS[%s_syndrome(word)]) >> 11);\n}\n\n", upper, lower)
+
+	fmt.Fprintf(b, "#endif /* %s_TABLES_H */\n", upper)
+	return b.Flush()
+}
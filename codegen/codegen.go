@@ -0,0 +1,68 @@
+// Package codegen generates C headers and synthesizable Verilog and VHDL modules
+// from the generator matrix, parity check matrix and coset leader table of the
+// golay package, so that firmware and hardware implementations use exactly the
+// same code as the Go implementation.
+//
+// All generated code uses the codeword layout of the golay package:
+// [data(12) | parity(11)], most significant bit first.
+package codegen
+
+import (
+	"fmt"
+	"math/bits"
+	"regexp"
+	"strings"
+
+	"github.com/yyyoichi/golay"
+)
+
+// Header is the first line of every generated file, without comment markers.
+const Header = "Code generated by github.com/yyyoichi/golay/codegen. DO NOT EDIT."
+
+var identifier = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// checkName returns an error if name cannot be used as a C, Verilog and VHDL identifier prefix.
+func checkName(name string) error {
+	if !identifier.MatchString(name) || strings.Contains(name, "__") || strings.HasSuffix(name, "_") {
+		return fmt.Errorf("invalid name %q: must be a letter followed by letters, digits or single underscores", name)
+	}
+	return nil
+}
+
+// terms returns the indexes of the set bits of row from the most significant of n bits down.
+func terms(row uint32, n int) []int {
+	idx := make([]int, 0, bits.OnesCount32(row))
+	for j := n - 1; j >= 0; j-- {
+		if row&(1<<j) != 0 {
+			idx = append(idx, j)
+		}
+	}
+	return idx
+}
+
+// parityTerms returns, for each parity bit from 10 down to 0, the data bits it depends on.
+func parityTerms() [11][]int {
+	var t [11][]int
+	for i, row := range golay.GeneratorMatrix() {
+		t[i] = terms(uint32(row), 12)
+	}
+	return t
+}
+
+// syndromeTerms returns, for each syndrome bit from 10 down to 0, the codeword bits it depends on.
+func syndromeTerms() [11][]int {
+	var t [11][]int
+	for i, row := range golay.ParityCheckMatrix() {
+		t[i] = terms(row, 23)
+	}
+	return t
+}
+
+// xorExpr joins the bits idx of the signal name with op, e.g. "d[11] ^ d[7]".
+func xorExpr(name string, idx []int, op, open, close string) string {
+	parts := make([]string, len(idx))
+	for i, j := range idx {
+		parts[i] = fmt.Sprintf("%s%s%d%s", name, open, j, close)
+	}
+	return strings.Join(parts, " "+op+" ")
+}
@@ -0,0 +1,196 @@
+package codegen
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yyyoichi/golay"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		file  string
+		write func(io.Writer, string) error
+	}{
+		{"golay.h", WriteCHeader},
+		{"golay.v", WriteVerilog},
+		{"golay.vhd", WriteVHDL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(&buf, "golay"); err != nil {
+				t.Fatalf("write failed: %v", err)
+			}
+			path := filepath.Join("testdata", tt.file)
+			if *update {
+				if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("output differs from %s; run go test -update to regenerate", path)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	for _, name := range []string{"", "1golay", "go-lay", "golay_", "go__lay", "go lay"} {
+		for _, write := range []func(io.Writer, string) error{WriteCHeader, WriteVerilog, WriteVHDL} {
+			if err := write(io.Discard, name); err == nil {
+				t.Errorf("name %q failed: got nil error, want error", name)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := WriteVerilog(&buf, "fec_a"); err != nil {
+		t.Fatalf("name %q failed: %v", "fec_a", err)
+	}
+	if !strings.Contains(buf.String(), "module fec_a_encoder (") || !strings.Contains(buf.String(), "module fec_a_decoder (") {
+		t.Errorf("name %q failed: module names not prefixed", "fec_a")
+	}
+}
+
+// TestVerilog evaluates the XOR equations and the case table of the generated
+// Verilog and compares them with the Go implementation.
+func TestVerilog(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteVerilog(&buf, "golay"); err != nil {
+		t.Fatal(err)
+	}
+	assign := regexp.MustCompile(`^\s*assign (parity|syndrome)\[(\d+)\] = (.*);$`)
+	entry := regexp.MustCompile(`^\s*11'h([0-9a-f]{3}): pattern = 23'h([0-9a-f]{6});$`)
+	var parity, syndrome [11][]int
+	table := make(map[uint16]uint32)
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		if m := assign.FindStringSubmatch(sc.Text()); m != nil {
+			bit, _ := strconv.Atoi(m[2])
+			var idx []int
+			for _, term := range strings.Split(m[3], " ^ ") {
+				j, _ := strconv.Atoi(term[strings.Index(term, "[")+1 : len(term)-1])
+				idx = append(idx, j)
+			}
+			if m[1] == "parity" {
+				parity[bit] = idx
+			} else {
+				syndrome[bit] = idx
+			}
+		}
+		if m := entry.FindStringSubmatch(sc.Text()); m != nil {
+			s, _ := strconv.ParseUint(m[1], 16, 16)
+			p, _ := strconv.ParseUint(m[2], 16, 32)
+			table[uint16(s)] = uint32(p)
+		}
+	}
+	if len(table) != 2047 {
+		t.Fatalf("case table failed: got %d entries, want %d", len(table), 2047)
+	}
+	xor := func(v uint32, idx []int) uint32 {
+		var x uint32
+		for _, j := range idx {
+			x ^= v >> j & 1
+		}
+		return x
+	}
+
+	for d := range uint16(4096) {
+		var p uint16
+		for bit, idx := range parity {
+			p |= uint16(xor(uint32(d), idx)) << bit
+		}
+		if want := golay.Encode(d); p != want {
+			t.Fatalf("encoder failed for %03x: got %03x, want %03x", d, p, want)
+		}
+	}
+	for i, c := range golay.Codewords() {
+		// one error pattern of weight up to 3 per codeword
+		word := uint32(c) ^ uint32(golay.CosetLeader(uint16(i*7%2048)))
+		var s uint16
+		for bit, idx := range syndrome {
+			s |= uint16(xor(word, idx)) << bit
+		}
+		if want := golay.Codeword(word).Syndrome(); s != want {
+			t.Fatalf("syndrome failed for %06x: got %03x, want %03x", word, s, want)
+		}
+		if got := uint16((word ^ table[s]) >> 11); got != uint16(i) {
+			t.Fatalf("decoder failed for %06x: got %03x, want %03x", word, got, i)
+		}
+	}
+}
+
+// TestCHeader compiles a program against the generated header and compares
+// its output with the Go implementation.
+func TestCHeader(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	dir := t.TempDir()
+	var header bytes.Buffer
+	if err := WriteCHeader(&header, "fec"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "fec.h"), header.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	src := `#include <stdio.h>
+#include "fec.h"
+
+int main(void)
+{
+	uint16_t d;
+
+	for (d = 0; d < 4096; d++) {
+		uint32_t c = fec_codeword(d);
+		uint32_t e = FEC_CORRECTIONS[(d * 7) % 2048];
+		printf("%u %u %u %u %u\n", fec_parity(d), c, fec_syndrome(c ^ e), fec_correct(c ^ e), fec_correct(c ^ 0x400001));
+	}
+	return 0;
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "main.c"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "main")
+	if out, err := exec.Command(cc, "-std=c99", "-Wall", "-Wextra", "-Werror", "-o", bin, filepath.Join(dir, "main.c")).CombinedOutput(); err != nil {
+		t.Fatalf("compiling the header failed: %v\n%s", err, out)
+	}
+	out, err := exec.Command(bin).Output()
+	if err != nil {
+		t.Fatalf("running the C program failed: %v", err)
+	}
+
+	var want strings.Builder
+	for d := range uint16(4096) {
+		c := golay.EncodeWord(d)
+		e := uint32(golay.CosetLeader(uint16(int(d) * 7 % 2048)))
+		fmt.Fprintf(&want, "%d %d %d %d %d\n", golay.Encode(d), c, golay.Codeword(c^e).Syndrome(), golay.Decode(c^e), golay.Decode(c^0x400001))
+	}
+	if string(out) != want.String() {
+		gotLines, wantLines := strings.Split(string(out), "\n"), strings.Split(want.String(), "\n")
+		for i := range min(len(gotLines), len(wantLines)) {
+			if gotLines[i] != wantLines[i] {
+				t.Fatalf("line %d differs: got %q, want %q", i, gotLines[i], wantLines[i])
+			}
+		}
+		t.Fatalf("C output has %d lines, want %d", len(gotLines), len(wantLines))
+	}
+}
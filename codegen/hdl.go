@@ -0,0 +1,107 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/yyyoichi/golay"
+)
+
+// WriteVerilog writes two synthesizable Verilog-2001 modules to w:
+//
+//	name_encoder  data[11:0] -> codeword[22:0]
+//	name_decoder  codeword[22:0] -> data[11:0], syndrome[10:0], corrected
+//
+// Both are purely combinational. The encoder computes the parity with XOR trees
+// derived from the generator matrix; the decoder computes the syndrome from the
+// parity check matrix and looks up the error pattern in a case statement holding
+// the coset leader table. corrected is high when the syndrome is not zero.
+func WriteVerilog(w io.Writer, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "// %s\n\n", Header)
+	fmt.Fprintf(b, "// Golay(23,12) encoder: codeword = {data, parity}.\n")
+	fmt.Fprintf(b, "module %s_encoder (\n", name)
+	fmt.Fprintf(b, "    input  wire [11:0] data,\n")
+	fmt.Fprintf(b, "    output wire [22:0] codeword\n);\n")
+	fmt.Fprintf(b, "    wire [10:0] parity;\n\n")
+	for i, idx := range parityTerms() {
+		fmt.Fprintf(b, "    assign parity[%d] = %s;\n", 10-i, xorExpr("data", idx, "^", "[", "]"))
+	}
+	fmt.Fprintf(b, "\n    assign codeword = {data, parity};\nendmodule\n\n")
+
+	fmt.Fprintf(b, "// Golay(23,12) syndrome decoder: corrects up to 3 bit errors.\n")
+	fmt.Fprintf(b, "module %s_decoder (\n", name)
+	fmt.Fprintf(b, "    input  wire [22:0] codeword,\n")
+	fmt.Fprintf(b, "    output wire [11:0] data,\n")
+	fmt.Fprintf(b, "    output wire [10:0] syndrome,\n")
+	fmt.Fprintf(b, "    output wire        corrected\n);\n")
+	fmt.Fprintf(b, "    reg  [22:0] pattern;\n")
+	fmt.Fprintf(b, "    wire [22:0] fixed;\n\n")
+	for i, idx := range syndromeTerms() {
+		fmt.Fprintf(b, "    assign syndrome[%d] = %s;\n", 10-i, xorExpr("codeword", idx, "^", "[", "]"))
+	}
+	fmt.Fprintf(b, "\n    always @(*) begin\n        case (syndrome)\n")
+	for s, leader := range golay.CosetLeaders()[1:] {
+		fmt.Fprintf(b, "            11'h%03x: pattern = 23'h%06x;\n", s+1, uint32(leader))
+	}
+	fmt.Fprintf(b, "            default: pattern = 23'h000000;\n        endcase\n    end\n\n")
+	fmt.Fprintf(b, "    assign fixed = codeword ^ pattern;\n")
+	fmt.Fprintf(b, "    assign data = fixed[22:11];\n")
+	fmt.Fprintf(b, "    assign corrected = |syndrome;\nendmodule\n")
+	return b.Flush()
+}
+
+// WriteVHDL writes two synthesizable VHDL-93 entities to w, with the same ports and
+// behavior as the modules of WriteVerilog:
+//
+//	name_encoder  data(11 downto 0) -> codeword(22 downto 0)
+//	name_decoder  codeword(22 downto 0) -> data(11 downto 0), syndrome(10 downto 0), corrected
+func WriteVHDL(w io.Writer, name string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "-- %s\n\n", Header)
+	fmt.Fprintf(b, "-- Golay(23,12) encoder: codeword = data & parity.\n")
+	fmt.Fprintf(b, "library ieee;\nuse ieee.std_logic_1164.all;\n\n")
+	fmt.Fprintf(b, "entity %s_encoder is\n    port (\n", name)
+	fmt.Fprintf(b, "        data     : in  std_logic_vector(11 downto 0);\n")
+	fmt.Fprintf(b, "        codeword : out std_logic_vector(22 downto 0)\n    );\n")
+	fmt.Fprintf(b, "end entity %s_encoder;\n\n", name)
+	fmt.Fprintf(b, "architecture rtl of %s_encoder is\n", name)
+	fmt.Fprintf(b, "    signal parity : std_logic_vector(10 downto 0);\nbegin\n")
+	for i, idx := range parityTerms() {
+		fmt.Fprintf(b, "    parity(%d) <= %s;\n", 10-i, xorExpr("data", idx, "xor", "(", ")"))
+	}
+	fmt.Fprintf(b, "\n    codeword <= data & parity;\nend architecture rtl;\n\n")
+
+	fmt.Fprintf(b, "-- Golay(23,12) syndrome decoder: corrects up to 3 bit errors.\n")
+	fmt.Fprintf(b, "library ieee;\nuse ieee.std_logic_1164.all;\n\n")
+	fmt.Fprintf(b, "entity %s_decoder is\n    port (\n", name)
+	fmt.Fprintf(b, "        codeword  : in  std_logic_vector(22 downto 0);\n")
+	fmt.Fprintf(b, "        data      : out std_logic_vector(11 downto 0);\n")
+	fmt.Fprintf(b, "        syndrome  : out std_logic_vector(10 downto 0);\n")
+	fmt.Fprintf(b, "        corrected : out std_logic\n    );\n")
+	fmt.Fprintf(b, "end entity %s_decoder;\n\n", name)
+	fmt.Fprintf(b, "architecture rtl of %s_decoder is\n", name)
+	fmt.Fprintf(b, "    signal s       : std_logic_vector(10 downto 0);\n")
+	fmt.Fprintf(b, "    signal pattern : std_logic_vector(22 downto 0);\n")
+	fmt.Fprintf(b, "    signal fixed   : std_logic_vector(22 downto 0);\nbegin\n")
+	for i, idx := range syndromeTerms() {
+		fmt.Fprintf(b, "    s(%d) <= %s;\n", 10-i, xorExpr("codeword", idx, "xor", "(", ")"))
+	}
+	fmt.Fprintf(b, "\n    process (s)\n    begin\n        case s is\n")
+	for s, leader := range golay.CosetLeaders()[1:] {
+		fmt.Fprintf(b, "            when \"%011b\" => pattern <= \"%023b\";\n", s+1, uint32(leader))
+	}
+	fmt.Fprintf(b, "            when others => pattern <= (others => '0');\n        end case;\n    end process;\n\n")
+	fmt.Fprintf(b, "    fixed <= codeword xor pattern;\n")
+	fmt.Fprintf(b, "    data <= fixed(22 downto 11);\n")
+	fmt.Fprintf(b, "    syndrome <= s;\n")
+	fmt.Fprintf(b, "    corrected <= '0' when s = \"00000000000\" else '1';\nend architecture rtl;\n")
+	return b.Flush()
+}
@@ -0,0 +1,2103 @@
+// Code generated by github.com/yyyoichi/golay/codegen. DO NOT EDIT.
+
+// Golay(23,12) encoder: codeword = {data, parity}.
+module golay_encoder (
+    input  wire [11:0] data,
+    output wire [22:0] codeword
+);
+    wire [10:0] parity;
+
+    assign parity[10] = data[11] ^ data[10] ^ data[9] ^ data[8] ^ data[7] ^ data[4] ^ data[1];
+    assign parity[9] = data[10] ^ data[9] ^ data[8] ^ data[7] ^ data[6] ^ data[3] ^ data[0];
+    assign parity[8] = data[11] ^ data[10] ^ data[6] ^ data[5] ^ data[4] ^ data[2] ^ data[1];
+    assign parity[7] = data[10] ^ data[9] ^ data[5] ^ data[4] ^ data[3] ^ data[1] ^ data[0];
+    assign parity[6] = data[11] ^ data[10] ^ data[7] ^ data[3] ^ data[2] ^ data[1] ^ data[0];
+    assign parity[5] = data[11] ^ data[8] ^ data[7] ^ data[6] ^ data[4] ^ data[2] ^ data[0];
+    assign parity[4] = data[11] ^ data[9] ^ data[8] ^ data[6] ^ data[5] ^ data[4] ^ data[3];
+    assign parity[3] = data[10] ^ data[8] ^ data[7] ^ data[5] ^ data[4] ^ data[3] ^ data[2];
+    assign parity[2] = data[9] ^ data[7] ^ data[6] ^ data[4] ^ data[3] ^ data[2] ^ data[1];
+    assign parity[1] = data[8] ^ data[6] ^ data[5] ^ data[3] ^ data[2] ^ data[1] ^ data[0];
+    assign parity[0] = data[11] ^ data[10] ^ data[9] ^ data[8] ^ data[5] ^ data[2] ^ data[0];
+
+    assign codeword = {data, parity};
+endmodule
+
+// Golay(23,12) syndrome decoder: corrects up to 3 bit errors.
+module golay_decoder (
+    input  wire [22:0] codeword,
+    output wire [11:0] data,
+    output wire [10:0] syndrome,
+    output wire        corrected
+);
+    reg  [22:0] pattern;
+    wire [22:0] fixed;
+
+    assign syndrome[10] = codeword[22] ^ codeword[21] ^ codeword[20] ^ codeword[19] ^ codeword[18] ^ codeword[15] ^ codeword[12] ^ codeword[10];
+    assign syndrome[9] = codeword[21] ^ codeword[20] ^ codeword[19] ^ codeword[18] ^ codeword[17] ^ codeword[14] ^ codeword[11] ^ codeword[9];
+    assign syndrome[8] = codeword[22] ^ codeword[21] ^ codeword[17] ^ codeword[16] ^ codeword[15] ^ codeword[13] ^ codeword[12] ^ codeword[8];
+    assign syndrome[7] = codeword[21] ^ codeword[20] ^ codeword[16] ^ codeword[15] ^ codeword[14] ^ codeword[12] ^ codeword[11] ^ codeword[7];
+    assign syndrome[6] = codeword[22] ^ codeword[21] ^ codeword[18] ^ codeword[14] ^ codeword[13] ^ codeword[12] ^ codeword[11] ^ codeword[6];
+    assign syndrome[5] = codeword[22] ^ codeword[19] ^ codeword[18] ^ codeword[17] ^ codeword[15] ^ codeword[13] ^ codeword[11] ^ codeword[5];
+    assign syndrome[4] = codeword[22] ^ codeword[20] ^ codeword[19] ^ codeword[17] ^ codeword[16] ^ codeword[15] ^ codeword[14] ^ codeword[4];
+    assign syndrome[3] = codeword[21] ^ codeword[19] ^ codeword[18] ^ codeword[16] ^ codeword[15] ^ codeword[14] ^ codeword[13] ^ codeword[3];
+    assign syndrome[2] = codeword[20] ^ codeword[18] ^ codeword[17] ^ codeword[15] ^ codeword[14] ^ codeword[13] ^ codeword[12] ^ codeword[2];
+    assign syndrome[1] = codeword[19] ^ codeword[17] ^ codeword[16] ^ codeword[14] ^ codeword[13] ^ codeword[12] ^ codeword[11] ^ codeword[1];
+    assign syndrome[0] = codeword[22] ^ codeword[21] ^ codeword[20] ^ codeword[19] ^ codeword[16] ^ codeword[13] ^ codeword[11] ^ codeword[0];
+
+    always @(*) begin
+        case (syndrome)
+            11'h001: pattern = 23'h000001;
+            11'h002: pattern = 23'h000002;
+            11'h003: pattern = 23'h000003;
+            11'h004: pattern = 23'h000004;
+            11'h005: pattern = 23'h000005;
+            11'h006: pattern = 23'h000006;
+            11'h007: pattern = 23'h000007;
+            11'h008: pattern = 23'h000008;
+            11'h009: pattern = 23'h000009;
+            11'h00a: pattern = 23'h00000a;
+            11'h00b: pattern = 23'h00000b;
+            11'h00c: pattern = 23'h00000c;
+            11'h00d: pattern = 23'h00000d;
+            11'h00e: pattern = 23'h00000e;
+            11'h00f: pattern = 23'h201200;
+            11'h010: pattern = 23'h000010;
+            11'h011: pattern = 23'h000011;
+            11'h012: pattern = 23'h000012;
+            11'h013: pattern = 23'h000013;
+            11'h014: pattern = 23'h000014;
+            11'h015: pattern = 23'h000015;
+            11'h016: pattern = 23'h000016;
+            11'h017: pattern = 23'h0c0040;
+            11'h018: pattern = 23'h000018;
+            11'h019: pattern = 23'h000019;
+            11'h01a: pattern = 23'h00001a;
+            11'h01b: pattern = 23'h010180;
+            11'h01c: pattern = 23'h00001c;
+            11'h01d: pattern = 23'h004820;
+            11'h01e: pattern = 23'h402400;
+            11'h01f: pattern = 23'h128000;
+            11'h020: pattern = 23'h000020;
+            11'h021: pattern = 23'h000021;
+            11'h022: pattern = 23'h000022;
+            11'h023: pattern = 23'h000023;
+            11'h024: pattern = 23'h000024;
+            11'h025: pattern = 23'h000025;
+            11'h026: pattern = 23'h000026;
+            11'h027: pattern = 23'h018400;
+            11'h028: pattern = 23'h000028;
+            11'h029: pattern = 23'h000029;
+            11'h02a: pattern = 23'h00002a;
+            11'h02b: pattern = 23'h460000;
+            11'h02c: pattern = 23'h00002c;
+            11'h02d: pattern = 23'h004810;
+            11'h02e: pattern = 23'h180080;
+            11'h02f: pattern = 23'h002140;
+            11'h030: pattern = 23'h000030;
+            11'h031: pattern = 23'h000031;
+            11'h032: pattern = 23'h000032;
+            11'h033: pattern = 23'h302000;
+            11'h034: pattern = 23'h000034;
+            11'h035: pattern = 23'h004808;
+            11'h036: pattern = 23'h020300;
+            11'h037: pattern = 23'h401080;
+            11'h038: pattern = 23'h000038;
+            11'h039: pattern = 23'h004804;
+            11'h03a: pattern = 23'h009040;
+            11'h03b: pattern = 23'h080600;
+            11'h03c: pattern = 23'h004801;
+            11'h03d: pattern = 23'h004800;
+            11'h03e: pattern = 23'h250000;
+            11'h03f: pattern = 23'h004802;
+            11'h040: pattern = 23'h000040;
+            11'h041: pattern = 23'h000041;
+            11'h042: pattern = 23'h000042;
+            11'h043: pattern = 23'h000043;
+            11'h044: pattern = 23'h000044;
+            11'h045: pattern = 23'h000045;
+            11'h046: pattern = 23'h000046;
+            11'h047: pattern = 23'h0c0010;
+            11'h048: pattern = 23'h000048;
+            11'h049: pattern = 23'h000049;
+            11'h04a: pattern = 23'h00004a;
+            11'h04b: pattern = 23'h104400;
+            11'h04c: pattern = 23'h00004c;
+            11'h04d: pattern = 23'h408080;
+            11'h04e: pattern = 23'h030800;
+            11'h04f: pattern = 23'h002120;
+            11'h050: pattern = 23'h000050;
+            11'h051: pattern = 23'h000051;
+            11'h052: pattern = 23'h000052;
+            11'h053: pattern = 23'h0c0004;
+            11'h054: pattern = 23'h000054;
+            11'h055: pattern = 23'h0c0002;
+            11'h056: pattern = 23'h0c0001;
+            11'h057: pattern = 23'h0c0000;
+            11'h058: pattern = 23'h000058;
+            11'h059: pattern = 23'h022200;
+            11'h05a: pattern = 23'h009020;
+            11'h05b: pattern = 23'h600800;
+            11'h05c: pattern = 23'h300100;
+            11'h05d: pattern = 23'h011400;
+            11'h05e: pattern = 23'h004280;
+            11'h05f: pattern = 23'h0c0008;
+            11'h060: pattern = 23'h000060;
+            11'h061: pattern = 23'h000061;
+            11'h062: pattern = 23'h000062;
+            11'h063: pattern = 23'h000a80;
+            11'h064: pattern = 23'h000064;
+            11'h065: pattern = 23'h121000;
+            11'h066: pattern = 23'h604000;
+            11'h067: pattern = 23'h002108;
+            11'h068: pattern = 23'h000068;
+            11'h069: pattern = 23'h290000;
+            11'h06a: pattern = 23'h009010;
+            11'h06b: pattern = 23'h002104;
+            11'h06c: pattern = 23'h040600;
+            11'h06d: pattern = 23'h002102;
+            11'h06e: pattern = 23'h002101;
+            11'h06f: pattern = 23'h002100;
+            11'h070: pattern = 23'h000070;
+            11'h071: pattern = 23'h400500;
+            11'h072: pattern = 23'h009008;
+            11'h073: pattern = 23'h034000;
+            11'h074: pattern = 23'h012080;
+            11'h075: pattern = 23'h208200;
+            11'h076: pattern = 23'h100c00;
+            11'h077: pattern = 23'h0c0020;
+            11'h078: pattern = 23'h009002;
+            11'h079: pattern = 23'h140080;
+            11'h07a: pattern = 23'h009000;
+            11'h07b: pattern = 23'h009001;
+            11'h07c: pattern = 23'h4a0000;
+            11'h07d: pattern = 23'h004840;
+            11'h07e: pattern = 23'h009004;
+            11'h07f: pattern = 23'h002110;
+            11'h080: pattern = 23'h000080;
+            11'h081: pattern = 23'h000081;
+            11'h082: pattern = 23'h000082;
+            11'h083: pattern = 23'h000083;
+            11'h084: pattern = 23'h000084;
+            11'h085: pattern = 23'h000085;
+            11'h086: pattern = 23'h000086;
+            11'h087: pattern = 23'h026000;
+            11'h088: pattern = 23'h000088;
+            11'h089: pattern = 23'h000089;
+            11'h08a: pattern = 23'h00008a;
+            11'h08b: pattern = 23'h010110;
+            11'h08c: pattern = 23'h00008c;
+            11'h08d: pattern = 23'h408040;
+            11'h08e: pattern = 23'h180020;
+            11'h08f: pattern = 23'h040c00;
+            11'h090: pattern = 23'h000090;
+            11'h091: pattern = 23'h000091;
+            11'h092: pattern = 23'h000092;
+            11'h093: pattern = 23'h010108;
+            11'h094: pattern = 23'h000094;
+            11'h095: pattern = 23'h100600;
+            11'h096: pattern = 23'h208800;
+            11'h097: pattern = 23'h401020;
+            11'h098: pattern = 23'h000098;
+            11'h099: pattern = 23'h010102;
+            11'h09a: pattern = 23'h010101;
+            11'h09b: pattern = 23'h010100;
+            11'h09c: pattern = 23'h061000;
+            11'h09d: pattern = 23'h282000;
+            11'h09e: pattern = 23'h004240;
+            11'h09f: pattern = 23'h010104;
+            11'h0a0: pattern = 23'h0000a0;
+            11'h0a1: pattern = 23'h0000a1;
+            11'h0a2: pattern = 23'h0000a2;
+            11'h0a3: pattern = 23'h000a40;
+            11'h0a4: pattern = 23'h0000a4;
+            11'h0a5: pattern = 23'h240100;
+            11'h0a6: pattern = 23'h180008;
+            11'h0a7: pattern = 23'h401010;
+            11'h0a8: pattern = 23'h0000a8;
+            11'h0a9: pattern = 23'h003400;
+            11'h0aa: pattern = 23'h180004;
+            11'h0ab: pattern = 23'h20c000;
+            11'h0ac: pattern = 23'h180002;
+            11'h0ad: pattern = 23'h030200;
+            11'h0ae: pattern = 23'h180000;
+            11'h0af: pattern = 23'h180001;
+            11'h0b0: pattern = 23'h0000b0;
+            11'h0b1: pattern = 23'h0a8000;
+            11'h0b2: pattern = 23'h044400;
+            11'h0b3: pattern = 23'h401004;
+            11'h0b4: pattern = 23'h012040;
+            11'h0b5: pattern = 23'h401002;
+            11'h0b6: pattern = 23'h401001;
+            11'h0b7: pattern = 23'h401000;
+            11'h0b8: pattern = 23'h600200;
+            11'h0b9: pattern = 23'h140040;
+            11'h0ba: pattern = 23'h022800;
+            11'h0bb: pattern = 23'h010120;
+            11'h0bc: pattern = 23'h008500;
+            11'h0bd: pattern = 23'h004880;
+            11'h0be: pattern = 23'h180010;
+            11'h0bf: pattern = 23'h401008;
+            11'h0c0: pattern = 23'h0000c0;
+            11'h0c1: pattern = 23'h0000c1;
+            11'h0c2: pattern = 23'h0000c2;
+            11'h0c3: pattern = 23'h000a20;
+            11'h0c4: pattern = 23'h0000c4;
+            11'h0c5: pattern = 23'h408008;
+            11'h0c6: pattern = 23'h001500;
+            11'h0c7: pattern = 23'h310000;
+            11'h0c8: pattern = 23'h0000c8;
+            11'h0c9: pattern = 23'h408004;
+            11'h0ca: pattern = 23'h242000;
+            11'h0cb: pattern = 23'h0a1000;
+            11'h0cc: pattern = 23'h408001;
+            11'h0cd: pattern = 23'h408000;
+            11'h0ce: pattern = 23'h004210;
+            11'h0cf: pattern = 23'h408002;
+            11'h0d0: pattern = 23'h0000d0;
+            11'h0d1: pattern = 23'h205000;
+            11'h0d2: pattern = 23'h520000;
+            11'h0d3: pattern = 23'h00a400;
+            11'h0d4: pattern = 23'h012020;
+            11'h0d5: pattern = 23'h020900;
+            11'h0d6: pattern = 23'h004208;
+            11'h0d7: pattern = 23'h0c0080;
+            11'h0d8: pattern = 23'h080c00;
+            11'h0d9: pattern = 23'h140020;
+            11'h0da: pattern = 23'h004204;
+            11'h0db: pattern = 23'h010140;
+            11'h0dc: pattern = 23'h004202;
+            11'h0dd: pattern = 23'h408010;
+            11'h0de: pattern = 23'h004200;
+            11'h0df: pattern = 23'h004201;
+            11'h0e0: pattern = 23'h0000e0;
+            11'h0e1: pattern = 23'h000a02;
+            11'h0e2: pattern = 23'h000a01;
+            11'h0e3: pattern = 23'h000a00;
+            11'h0e4: pattern = 23'h012010;
+            11'h0e5: pattern = 23'h084400;
+            11'h0e6: pattern = 23'h068000;
+            11'h0e7: pattern = 23'h000a04;
+            11'h0e8: pattern = 23'h024100;
+            11'h0e9: pattern = 23'h140010;
+            11'h0ea: pattern = 23'h410400;
+            11'h0eb: pattern = 23'h000a08;
+            11'h0ec: pattern = 23'h201800;
+            11'h0ed: pattern = 23'h408020;
+            11'h0ee: pattern = 23'h180040;
+            11'h0ef: pattern = 23'h002180;
+            11'h0f0: pattern = 23'h012004;
+            11'h0f1: pattern = 23'h140008;
+            11'h0f2: pattern = 23'h280100;
+            11'h0f3: pattern = 23'h000a10;
+            11'h0f4: pattern = 23'h012000;
+            11'h0f5: pattern = 23'h012001;
+            11'h0f6: pattern = 23'h012002;
+            11'h0f7: pattern = 23'h401040;
+            11'h0f8: pattern = 23'h140001;
+            11'h0f9: pattern = 23'h140000;
+            11'h0fa: pattern = 23'h009080;
+            11'h0fb: pattern = 23'h140002;
+            11'h0fc: pattern = 23'h012008;
+            11'h0fd: pattern = 23'h140004;
+            11'h0fe: pattern = 23'h004220;
+            11'h0ff: pattern = 23'h220400;
+            11'h100: pattern = 23'h000100;
+            11'h101: pattern = 23'h000101;
+            11'h102: pattern = 23'h000102;
+            11'h103: pattern = 23'h000103;
+            11'h104: pattern = 23'h000104;
+            11'h105: pattern = 23'h000105;
+            11'h106: pattern = 23'h000106;
+            11'h107: pattern = 23'h500800;
+            11'h108: pattern = 23'h000108;
+            11'h109: pattern = 23'h000109;
+            11'h10a: pattern = 23'h00010a;
+            11'h10b: pattern = 23'h010090;
+            11'h10c: pattern = 23'h00010c;
+            11'h10d: pattern = 23'h0a0400;
+            11'h10e: pattern = 23'h04c000;
+            11'h10f: pattern = 23'h002060;
+            11'h110: pattern = 23'h000110;
+            11'h111: pattern = 23'h000111;
+            11'h112: pattern = 23'h000112;
+            11'h113: pattern = 23'h010088;
+            11'h114: pattern = 23'h000114;
+            11'h115: pattern = 23'h00b000;
+            11'h116: pattern = 23'h020220;
+            11'h117: pattern = 23'h204400;
+            11'h118: pattern = 23'h000118;
+            11'h119: pattern = 23'h010082;
+            11'h11a: pattern = 23'h010081;
+            11'h11b: pattern = 23'h010080;
+            11'h11c: pattern = 23'h300040;
+            11'h11d: pattern = 23'h440200;
+            11'h11e: pattern = 23'h081800;
+            11'h11f: pattern = 23'h010084;
+            11'h120: pattern = 23'h000120;
+            11'h121: pattern = 23'h000121;
+            11'h122: pattern = 23'h000122;
+            11'h123: pattern = 23'h085000;
+            11'h124: pattern = 23'h000124;
+            11'h125: pattern = 23'h240080;
+            11'h126: pattern = 23'h020210;
+            11'h127: pattern = 23'h002048;
+            11'h128: pattern = 23'h000128;
+            11'h129: pattern = 23'h108200;
+            11'h12a: pattern = 23'h200c00;
+            11'h12b: pattern = 23'h002044;
+            11'h12c: pattern = 23'h411000;
+            11'h12d: pattern = 23'h002042;
+            11'h12e: pattern = 23'h002041;
+            11'h12f: pattern = 23'h002040;
+            11'h130: pattern = 23'h000130;
+            11'h131: pattern = 23'h400440;
+            11'h132: pattern = 23'h020204;
+            11'h133: pattern = 23'h048800;
+            11'h134: pattern = 23'h020202;
+            11'h135: pattern = 23'h190000;
+            11'h136: pattern = 23'h020200;
+            11'h137: pattern = 23'h020201;
+            11'h138: pattern = 23'h0c2000;
+            11'h139: pattern = 23'h221000;
+            11'h13a: pattern = 23'h504000;
+            11'h13b: pattern = 23'h0100a0;
+            11'h13c: pattern = 23'h008480;
+            11'h13d: pattern = 23'h004900;
+            11'h13e: pattern = 23'h020208;
+            11'h13f: pattern = 23'h002050;
+            11'h140: pattern = 23'h000140;
+            11'h141: pattern = 23'h000141;
+            11'h142: pattern = 23'h000142;
+            11'h143: pattern = 23'h228000;
+            11'h144: pattern = 23'h000144;
+            11'h145: pattern = 23'h014200;
+            11'h146: pattern = 23'h001480;
+            11'h147: pattern = 23'h002028;
+            11'h148: pattern = 23'h000148;
+            11'h149: pattern = 23'h041800;
+            11'h14a: pattern = 23'h480200;
+            11'h14b: pattern = 23'h002024;
+            11'h14c: pattern = 23'h300010;
+            11'h14d: pattern = 23'h002022;
+            11'h14e: pattern = 23'h002021;
+            11'h14f: pattern = 23'h002020;
+            11'h150: pattern = 23'h000150;
+            11'h151: pattern = 23'h400420;
+            11'h152: pattern = 23'h006800;
+            11'h153: pattern = 23'h101200;
+            11'h154: pattern = 23'h300008;
+            11'h155: pattern = 23'h020880;
+            11'h156: pattern = 23'h418000;
+            11'h157: pattern = 23'h0c0100;
+            11'h158: pattern = 23'h300004;
+            11'h159: pattern = 23'h08c000;
+            11'h15a: pattern = 23'h060400;
+            11'h15b: pattern = 23'h0100c0;
+            11'h15c: pattern = 23'h300000;
+            11'h15d: pattern = 23'h300001;
+            11'h15e: pattern = 23'h300002;
+            11'h15f: pattern = 23'h002030;
+            11'h160: pattern = 23'h000160;
+            11'h161: pattern = 23'h400410;
+            11'h162: pattern = 23'h150000;
+            11'h163: pattern = 23'h00200c;
+            11'h164: pattern = 23'h088800;
+            11'h165: pattern = 23'h00200a;
+            11'h166: pattern = 23'h002009;
+            11'h167: pattern = 23'h002008;
+            11'h168: pattern = 23'h024080;
+            11'h169: pattern = 23'h002006;
+            11'h16a: pattern = 23'h002005;
+            11'h16b: pattern = 23'h002004;
+            11'h16c: pattern = 23'h002003;
+            11'h16d: pattern = 23'h002002;
+            11'h16e: pattern = 23'h002001;
+            11'h16f: pattern = 23'h002000;
+            11'h170: pattern = 23'h400401;
+            11'h171: pattern = 23'h400400;
+            11'h172: pattern = 23'h280080;
+            11'h173: pattern = 23'h400402;
+            11'h174: pattern = 23'h045000;
+            11'h175: pattern = 23'h400404;
+            11'h176: pattern = 23'h020240;
+            11'h177: pattern = 23'h002018;
+            11'h178: pattern = 23'h010a00;
+            11'h179: pattern = 23'h400408;
+            11'h17a: pattern = 23'h009100;
+            11'h17b: pattern = 23'h002014;
+            11'h17c: pattern = 23'h300020;
+            11'h17d: pattern = 23'h002012;
+            11'h17e: pattern = 23'h002011;
+            11'h17f: pattern = 23'h002010;
+            11'h180: pattern = 23'h000180;
+            11'h181: pattern = 23'h000181;
+            11'h182: pattern = 23'h000182;
+            11'h183: pattern = 23'h010018;
+            11'h184: pattern = 23'h000184;
+            11'h185: pattern = 23'h240020;
+            11'h186: pattern = 23'h001440;
+            11'h187: pattern = 23'h088200;
+            11'h188: pattern = 23'h000188;
+            11'h189: pattern = 23'h010012;
+            11'h18a: pattern = 23'h010011;
+            11'h18b: pattern = 23'h010010;
+            11'h18c: pattern = 23'h002a00;
+            11'h18d: pattern = 23'h105000;
+            11'h18e: pattern = 23'h620000;
+            11'h18f: pattern = 23'h010014;
+            11'h190: pattern = 23'h000190;
+            11'h191: pattern = 23'h01000a;
+            11'h192: pattern = 23'h010009;
+            11'h193: pattern = 23'h010008;
+            11'h194: pattern = 23'h484000;
+            11'h195: pattern = 23'h020840;
+            11'h196: pattern = 23'h142000;
+            11'h197: pattern = 23'h01000c;
+            11'h198: pattern = 23'h010003;
+            11'h199: pattern = 23'h010002;
+            11'h19a: pattern = 23'h010001;
+            11'h19b: pattern = 23'h010000;
+            11'h19c: pattern = 23'h008420;
+            11'h19d: pattern = 23'h010006;
+            11'h19e: pattern = 23'h010005;
+            11'h19f: pattern = 23'h010004;
+            11'h1a0: pattern = 23'h0001a0;
+            11'h1a1: pattern = 23'h240004;
+            11'h1a2: pattern = 23'h40a000;
+            11'h1a3: pattern = 23'h120400;
+            11'h1a4: pattern = 23'h240001;
+            11'h1a5: pattern = 23'h240000;
+            11'h1a6: pattern = 23'h014800;
+            11'h1a7: pattern = 23'h240002;
+            11'h1a8: pattern = 23'h024040;
+            11'h1a9: pattern = 23'h480800;
+            11'h1aa: pattern = 23'h041200;
+            11'h1ab: pattern = 23'h010030;
+            11'h1ac: pattern = 23'h008410;
+            11'h1ad: pattern = 23'h240008;
+            11'h1ae: pattern = 23'h180100;
+            11'h1af: pattern = 23'h0020c0;
+            11'h1b0: pattern = 23'h101800;
+            11'h1b1: pattern = 23'h006200;
+            11'h1b2: pattern = 23'h280040;
+            11'h1b3: pattern = 23'h010028;
+            11'h1b4: pattern = 23'h008408;
+            11'h1b5: pattern = 23'h240010;
+            11'h1b6: pattern = 23'h020280;
+            11'h1b7: pattern = 23'h401100;
+            11'h1b8: pattern = 23'h008404;
+            11'h1b9: pattern = 23'h010022;
+            11'h1ba: pattern = 23'h010021;
+            11'h1bb: pattern = 23'h010020;
+            11'h1bc: pattern = 23'h008400;
+            11'h1bd: pattern = 23'h008401;
+            11'h1be: pattern = 23'h008402;
+            11'h1bf: pattern = 23'h010024;
+            11'h1c0: pattern = 23'h0001c0;
+            11'h1c1: pattern = 23'h182000;
+            11'h1c2: pattern = 23'h001404;
+            11'h1c3: pattern = 23'h444000;
+            11'h1c4: pattern = 23'h001402;
+            11'h1c5: pattern = 23'h020810;
+            11'h1c6: pattern = 23'h001400;
+            11'h1c7: pattern = 23'h001401;
+            11'h1c8: pattern = 23'h024020;
+            11'h1c9: pattern = 23'h200600;
+            11'h1ca: pattern = 23'h108800;
+            11'h1cb: pattern = 23'h010050;
+            11'h1cc: pattern = 23'h0d0000;
+            11'h1cd: pattern = 23'h408100;
+            11'h1ce: pattern = 23'h001408;
+            11'h1cf: pattern = 23'h0020a0;
+            11'h1d0: pattern = 23'h048200;
+            11'h1d1: pattern = 23'h020804;
+            11'h1d2: pattern = 23'h280020;
+            11'h1d3: pattern = 23'h010048;
+            11'h1d4: pattern = 23'h020801;
+            11'h1d5: pattern = 23'h020800;
+            11'h1d6: pattern = 23'h001410;
+            11'h1d7: pattern = 23'h020802;
+            11'h1d8: pattern = 23'h403000;
+            11'h1d9: pattern = 23'h010042;
+            11'h1da: pattern = 23'h010041;
+            11'h1db: pattern = 23'h010040;
+            11'h1dc: pattern = 23'h300080;
+            11'h1dd: pattern = 23'h020808;
+            11'h1de: pattern = 23'h004300;
+            11'h1df: pattern = 23'h010044;
+            11'h1e0: pattern = 23'h024008;
+            11'h1e1: pattern = 23'h019000;
+            11'h1e2: pattern = 23'h280010;
+            11'h1e3: pattern = 23'h000b00;
+            11'h1e4: pattern = 23'h500200;
+            11'h1e5: pattern = 23'h240040;
+            11'h1e6: pattern = 23'h001420;
+            11'h1e7: pattern = 23'h002088;
+            11'h1e8: pattern = 23'h024000;
+            11'h1e9: pattern = 23'h024001;
+            11'h1ea: pattern = 23'h024002;
+            11'h1eb: pattern = 23'h002084;
+            11'h1ec: pattern = 23'h024004;
+            11'h1ed: pattern = 23'h002082;
+            11'h1ee: pattern = 23'h002081;
+            11'h1ef: pattern = 23'h002080;
+            11'h1f0: pattern = 23'h280002;
+            11'h1f1: pattern = 23'h400480;
+            11'h1f2: pattern = 23'h280000;
+            11'h1f3: pattern = 23'h280001;
+            11'h1f4: pattern = 23'h012100;
+            11'h1f5: pattern = 23'h020820;
+            11'h1f6: pattern = 23'h280004;
+            11'h1f7: pattern = 23'h10c000;
+            11'h1f8: pattern = 23'h024010;
+            11'h1f9: pattern = 23'h140100;
+            11'h1fa: pattern = 23'h280008;
+            11'h1fb: pattern = 23'h010060;
+            11'h1fc: pattern = 23'h008440;
+            11'h1fd: pattern = 23'h081200;
+            11'h1fe: pattern = 23'h440800;
+            11'h1ff: pattern = 23'h002090;
+            11'h200: pattern = 23'h000200;
+            11'h201: pattern = 23'h000201;
+            11'h202: pattern = 23'h000202;
+            11'h203: pattern = 23'h000203;
+            11'h204: pattern = 23'h000204;
+            11'h205: pattern = 23'h000205;
+            11'h206: pattern = 23'h000206;
+            11'h207: pattern = 23'h201008;
+            11'h208: pattern = 23'h000208;
+            11'h209: pattern = 23'h000209;
+            11'h20a: pattern = 23'h00020a;
+            11'h20b: pattern = 23'h201004;
+            11'h20c: pattern = 23'h00020c;
+            11'h20d: pattern = 23'h201002;
+            11'h20e: pattern = 23'h201001;
+            11'h20f: pattern = 23'h201000;
+            11'h210: pattern = 23'h000210;
+            11'h211: pattern = 23'h000211;
+            11'h212: pattern = 23'h000212;
+            11'h213: pattern = 23'h40c000;
+            11'h214: pattern = 23'h000214;
+            11'h215: pattern = 23'h100480;
+            11'h216: pattern = 23'h020120;
+            11'h217: pattern = 23'h012800;
+            11'h218: pattern = 23'h000218;
+            11'h219: pattern = 23'h022040;
+            11'h21a: pattern = 23'h140800;
+            11'h21b: pattern = 23'h080420;
+            11'h21c: pattern = 23'h098000;
+            11'h21d: pattern = 23'h440100;
+            11'h21e: pattern = 23'h0040c0;
+            11'h21f: pattern = 23'h201010;
+            11'h220: pattern = 23'h000220;
+            11'h221: pattern = 23'h000221;
+            11'h222: pattern = 23'h000222;
+            11'h223: pattern = 23'h0008c0;
+            11'h224: pattern = 23'h000224;
+            11'h225: pattern = 23'h482000;
+            11'h226: pattern = 23'h020110;
+            11'h227: pattern = 23'h144000;
+            11'h228: pattern = 23'h000228;
+            11'h229: pattern = 23'h108100;
+            11'h22a: pattern = 23'h016000;
+            11'h22b: pattern = 23'h080410;
+            11'h22c: pattern = 23'h040440;
+            11'h22d: pattern = 23'h030080;
+            11'h22e: pattern = 23'h408800;
+            11'h22f: pattern = 23'h201020;
+            11'h230: pattern = 23'h000230;
+            11'h231: pattern = 23'h051000;
+            11'h232: pattern = 23'h020104;
+            11'h233: pattern = 23'h080408;
+            11'h234: pattern = 23'h020102;
+            11'h235: pattern = 23'h208040;
+            11'h236: pattern = 23'h020100;
+            11'h237: pattern = 23'h020101;
+            11'h238: pattern = 23'h600080;
+            11'h239: pattern = 23'h080402;
+            11'h23a: pattern = 23'h080401;
+            11'h23b: pattern = 23'h080400;
+            11'h23c: pattern = 23'h103000;
+            11'h23d: pattern = 23'h004a00;
+            11'h23e: pattern = 23'h020108;
+            11'h23f: pattern = 23'h080404;
+            11'h240: pattern = 23'h000240;
+            11'h241: pattern = 23'h000241;
+            11'h242: pattern = 23'h000242;
+            11'h243: pattern = 23'h0008a0;
+            11'h244: pattern = 23'h000244;
+            11'h245: pattern = 23'h014100;
+            11'h246: pattern = 23'h10a000;
+            11'h247: pattern = 23'h420400;
+            11'h248: pattern = 23'h000248;
+            11'h249: pattern = 23'h022010;
+            11'h24a: pattern = 23'h480100;
+            11'h24b: pattern = 23'h058000;
+            11'h24c: pattern = 23'h040420;
+            11'h24d: pattern = 23'h180800;
+            11'h24e: pattern = 23'h004090;
+            11'h24f: pattern = 23'h201040;
+            11'h250: pattern = 23'h000250;
+            11'h251: pattern = 23'h022008;
+            11'h252: pattern = 23'h210400;
+            11'h253: pattern = 23'h101100;
+            11'h254: pattern = 23'h401800;
+            11'h255: pattern = 23'h208020;
+            11'h256: pattern = 23'h004088;
+            11'h257: pattern = 23'h0c0200;
+            11'h258: pattern = 23'h022001;
+            11'h259: pattern = 23'h022000;
+            11'h25a: pattern = 23'h004084;
+            11'h25b: pattern = 23'h022002;
+            11'h25c: pattern = 23'h004082;
+            11'h25d: pattern = 23'h022004;
+            11'h25e: pattern = 23'h004080;
+            11'h25f: pattern = 23'h004081;
+            11'h260: pattern = 23'h000260;
+            11'h261: pattern = 23'h000882;
+            11'h262: pattern = 23'h000881;
+            11'h263: pattern = 23'h000880;
+            11'h264: pattern = 23'h040408;
+            11'h265: pattern = 23'h208010;
+            11'h266: pattern = 23'h091000;
+            11'h267: pattern = 23'h000884;
+            11'h268: pattern = 23'h040404;
+            11'h269: pattern = 23'h405000;
+            11'h26a: pattern = 23'h320000;
+            11'h26b: pattern = 23'h000888;
+            11'h26c: pattern = 23'h040400;
+            11'h26d: pattern = 23'h040401;
+            11'h26e: pattern = 23'h040402;
+            11'h26f: pattern = 23'h002300;
+            11'h270: pattern = 23'h184000;
+            11'h271: pattern = 23'h208004;
+            11'h272: pattern = 23'h442000;
+            11'h273: pattern = 23'h000890;
+            11'h274: pattern = 23'h208001;
+            11'h275: pattern = 23'h208000;
+            11'h276: pattern = 23'h020140;
+            11'h277: pattern = 23'h208002;
+            11'h278: pattern = 23'h010900;
+            11'h279: pattern = 23'h022020;
+            11'h27a: pattern = 23'h009200;
+            11'h27b: pattern = 23'h080440;
+            11'h27c: pattern = 23'h040410;
+            11'h27d: pattern = 23'h208008;
+            11'h27e: pattern = 23'h0040a0;
+            11'h27f: pattern = 23'h510000;
+            11'h280: pattern = 23'h000280;
+            11'h281: pattern = 23'h000281;
+            11'h282: pattern = 23'h000282;
+            11'h283: pattern = 23'h000860;
+            11'h284: pattern = 23'h000284;
+            11'h285: pattern = 23'h100410;
+            11'h286: pattern = 23'h450000;
+            11'h287: pattern = 23'h088100;
+            11'h288: pattern = 23'h000288;
+            11'h289: pattern = 23'h0c4000;
+            11'h28a: pattern = 23'h028400;
+            11'h28b: pattern = 23'h502000;
+            11'h28c: pattern = 23'h002900;
+            11'h28d: pattern = 23'h030020;
+            11'h28e: pattern = 23'h004050;
+            11'h28f: pattern = 23'h201080;
+            11'h290: pattern = 23'h000290;
+            11'h291: pattern = 23'h100404;
+            11'h292: pattern = 23'h083000;
+            11'h293: pattern = 23'h260000;
+            11'h294: pattern = 23'h100401;
+            11'h295: pattern = 23'h100400;
+            11'h296: pattern = 23'h004048;
+            11'h297: pattern = 23'h100402;
+            11'h298: pattern = 23'h600020;
+            11'h299: pattern = 23'h009800;
+            11'h29a: pattern = 23'h004044;
+            11'h29b: pattern = 23'h010300;
+            11'h29c: pattern = 23'h004042;
+            11'h29d: pattern = 23'h100408;
+            11'h29e: pattern = 23'h004040;
+            11'h29f: pattern = 23'h004041;
+            11'h2a0: pattern = 23'h0002a0;
+            11'h2a1: pattern = 23'h000842;
+            11'h2a2: pattern = 23'h000841;
+            11'h2a3: pattern = 23'h000840;
+            11'h2a4: pattern = 23'h00d000;
+            11'h2a5: pattern = 23'h030008;
+            11'h2a6: pattern = 23'h202400;
+            11'h2a7: pattern = 23'h000844;
+            11'h2a8: pattern = 23'h600010;
+            11'h2a9: pattern = 23'h030004;
+            11'h2aa: pattern = 23'h041100;
+            11'h2ab: pattern = 23'h000848;
+            11'h2ac: pattern = 23'h030001;
+            11'h2ad: pattern = 23'h030000;
+            11'h2ae: pattern = 23'h180200;
+            11'h2af: pattern = 23'h030002;
+            11'h2b0: pattern = 23'h600008;
+            11'h2b1: pattern = 23'h006100;
+            11'h2b2: pattern = 23'h118000;
+            11'h2b3: pattern = 23'h000850;
+            11'h2b4: pattern = 23'h0c0800;
+            11'h2b5: pattern = 23'h100420;
+            11'h2b6: pattern = 23'h020180;
+            11'h2b7: pattern = 23'h401200;
+            11'h2b8: pattern = 23'h600000;
+            11'h2b9: pattern = 23'h600001;
+            11'h2ba: pattern = 23'h600002;
+            11'h2bb: pattern = 23'h080480;
+            11'h2bc: pattern = 23'h600004;
+            11'h2bd: pattern = 23'h030010;
+            11'h2be: pattern = 23'h004060;
+            11'h2bf: pattern = 23'h04a000;
+            11'h2c0: pattern = 23'h0002c0;
+            11'h2c1: pattern = 23'h000822;
+            11'h2c2: pattern = 23'h000821;
+            11'h2c3: pattern = 23'h000820;
+            11'h2c4: pattern = 23'h2a0000;
+            11'h2c5: pattern = 23'h043000;
+            11'h2c6: pattern = 23'h004018;
+            11'h2c7: pattern = 23'h000824;
+            11'h2c8: pattern = 23'h111000;
+            11'h2c9: pattern = 23'h200500;
+            11'h2ca: pattern = 23'h004014;
+            11'h2cb: pattern = 23'h000828;
+            11'h2cc: pattern = 23'h004012;
+            11'h2cd: pattern = 23'h408200;
+            11'h2ce: pattern = 23'h004010;
+            11'h2cf: pattern = 23'h004011;
+            11'h2d0: pattern = 23'h048100;
+            11'h2d1: pattern = 23'h490000;
+            11'h2d2: pattern = 23'h00400c;
+            11'h2d3: pattern = 23'h000830;
+            11'h2d4: pattern = 23'h00400a;
+            11'h2d5: pattern = 23'h100440;
+            11'h2d6: pattern = 23'h004008;
+            11'h2d7: pattern = 23'h004009;
+            11'h2d8: pattern = 23'h004006;
+            11'h2d9: pattern = 23'h022080;
+            11'h2da: pattern = 23'h004004;
+            11'h2db: pattern = 23'h004005;
+            11'h2dc: pattern = 23'h004002;
+            11'h2dd: pattern = 23'h004003;
+            11'h2de: pattern = 23'h004000;
+            11'h2df: pattern = 23'h004001;
+            11'h2e0: pattern = 23'h000803;
+            11'h2e1: pattern = 23'h000802;
+            11'h2e2: pattern = 23'h000801;
+            11'h2e3: pattern = 23'h000800;
+            11'h2e4: pattern = 23'h500100;
+            11'h2e5: pattern = 23'h000806;
+            11'h2e6: pattern = 23'h000805;
+            11'h2e7: pattern = 23'h000804;
+            11'h2e8: pattern = 23'h08a000;
+            11'h2e9: pattern = 23'h00080a;
+            11'h2ea: pattern = 23'h000809;
+            11'h2eb: pattern = 23'h000808;
+            11'h2ec: pattern = 23'h040480;
+            11'h2ed: pattern = 23'h030040;
+            11'h2ee: pattern = 23'h004030;
+            11'h2ef: pattern = 23'h00080c;
+            11'h2f0: pattern = 23'h021400;
+            11'h2f1: pattern = 23'h000812;
+            11'h2f2: pattern = 23'h000811;
+            11'h2f3: pattern = 23'h000810;
+            11'h2f4: pattern = 23'h012200;
+            11'h2f5: pattern = 23'h208080;
+            11'h2f6: pattern = 23'h004028;
+            11'h2f7: pattern = 23'h000814;
+            11'h2f8: pattern = 23'h600040;
+            11'h2f9: pattern = 23'h140200;
+            11'h2fa: pattern = 23'h004024;
+            11'h2fb: pattern = 23'h000818;
+            11'h2fc: pattern = 23'h004022;
+            11'h2fd: pattern = 23'h081100;
+            11'h2fe: pattern = 23'h004020;
+            11'h2ff: pattern = 23'h004021;
+            11'h300: pattern = 23'h000300;
+            11'h301: pattern = 23'h000301;
+            11'h302: pattern = 23'h000302;
+            11'h303: pattern = 23'h042400;
+            11'h304: pattern = 23'h000304;
+            11'h305: pattern = 23'h014040;
+            11'h306: pattern = 23'h020030;
+            11'h307: pattern = 23'h088080;
+            11'h308: pattern = 23'h000308;
+            11'h309: pattern = 23'h108020;
+            11'h30a: pattern = 23'h480040;
+            11'h30b: pattern = 23'h024800;
+            11'h30c: pattern = 23'h002880;
+            11'h30d: pattern = 23'h440010;
+            11'h30e: pattern = 23'h110400;
+            11'h30f: pattern = 23'h201100;
+            11'h310: pattern = 23'h000310;
+            11'h311: pattern = 23'h280800;
+            11'h312: pattern = 23'h020024;
+            11'h313: pattern = 23'h101040;
+            11'h314: pattern = 23'h020022;
+            11'h315: pattern = 23'h440008;
+            11'h316: pattern = 23'h020020;
+            11'h317: pattern = 23'h020021;
+            11'h318: pattern = 23'h005400;
+            11'h319: pattern = 23'h440004;
+            11'h31a: pattern = 23'h20a000;
+            11'h31b: pattern = 23'h010280;
+            11'h31c: pattern = 23'h440001;
+            11'h31d: pattern = 23'h440000;
+            11'h31e: pattern = 23'h020028;
+            11'h31f: pattern = 23'h440002;
+            11'h320: pattern = 23'h000320;
+            11'h321: pattern = 23'h108008;
+            11'h322: pattern = 23'h020014;
+            11'h323: pattern = 23'h610000;
+            11'h324: pattern = 23'h020012;
+            11'h325: pattern = 23'h001c00;
+            11'h326: pattern = 23'h020010;
+            11'h327: pattern = 23'h020011;
+            11'h328: pattern = 23'h108001;
+            11'h329: pattern = 23'h108000;
+            11'h32a: pattern = 23'h041080;
+            11'h32b: pattern = 23'h108002;
+            11'h32c: pattern = 23'h284000;
+            11'h32d: pattern = 23'h108004;
+            11'h32e: pattern = 23'h020018;
+            11'h32f: pattern = 23'h002240;
+            11'h330: pattern = 23'h020006;
+            11'h331: pattern = 23'h006080;
+            11'h332: pattern = 23'h020004;
+            11'h333: pattern = 23'h020005;
+            11'h334: pattern = 23'h020002;
+            11'h335: pattern = 23'h020003;
+            11'h336: pattern = 23'h020000;
+            11'h337: pattern = 23'h020001;
+            11'h338: pattern = 23'h010840;
+            11'h339: pattern = 23'h108010;
+            11'h33a: pattern = 23'h02000c;
+            11'h33b: pattern = 23'h080500;
+            11'h33c: pattern = 23'h02000a;
+            11'h33d: pattern = 23'h440020;
+            11'h33e: pattern = 23'h020008;
+            11'h33f: pattern = 23'h020009;
+            11'h340: pattern = 23'h000340;
+            11'h341: pattern = 23'h014004;
+            11'h342: pattern = 23'h480008;
+            11'h343: pattern = 23'h101010;
+            11'h344: pattern = 23'h014001;
+            11'h345: pattern = 23'h014000;
+            11'h346: pattern = 23'h240800;
+            11'h347: pattern = 23'h014002;
+            11'h348: pattern = 23'h480002;
+            11'h349: pattern = 23'h200480;
+            11'h34a: pattern = 23'h480000;
+            11'h34b: pattern = 23'h480001;
+            11'h34c: pattern = 23'h029000;
+            11'h34d: pattern = 23'h014008;
+            11'h34e: pattern = 23'h480004;
+            11'h34f: pattern = 23'h002220;
+            11'h350: pattern = 23'h048080;
+            11'h351: pattern = 23'h101002;
+            11'h352: pattern = 23'h101001;
+            11'h353: pattern = 23'h101000;
+            11'h354: pattern = 23'h082400;
+            11'h355: pattern = 23'h014010;
+            11'h356: pattern = 23'h020060;
+            11'h357: pattern = 23'h101004;
+            11'h358: pattern = 23'h010820;
+            11'h359: pattern = 23'h022100;
+            11'h35a: pattern = 23'h480010;
+            11'h35b: pattern = 23'h101008;
+            11'h35c: pattern = 23'h300200;
+            11'h35d: pattern = 23'h440040;
+            11'h35e: pattern = 23'h004180;
+            11'h35f: pattern = 23'h008c00;
+            11'h360: pattern = 23'h203000;
+            11'h361: pattern = 23'h0e0000;
+            11'h362: pattern = 23'h00c400;
+            11'h363: pattern = 23'h000980;
+            11'h364: pattern = 23'h500080;
+            11'h365: pattern = 23'h014020;
+            11'h366: pattern = 23'h020050;
+            11'h367: pattern = 23'h002208;
+            11'h368: pattern = 23'h010810;
+            11'h369: pattern = 23'h108040;
+            11'h36a: pattern = 23'h480020;
+            11'h36b: pattern = 23'h002204;
+            11'h36c: pattern = 23'h040500;
+            11'h36d: pattern = 23'h002202;
+            11'h36e: pattern = 23'h002201;
+            11'h36f: pattern = 23'h002200;
+            11'h370: pattern = 23'h010808;
+            11'h371: pattern = 23'h400600;
+            11'h372: pattern = 23'h020044;
+            11'h373: pattern = 23'h101020;
+            11'h374: pattern = 23'h020042;
+            11'h375: pattern = 23'h208100;
+            11'h376: pattern = 23'h020040;
+            11'h377: pattern = 23'h020041;
+            11'h378: pattern = 23'h010800;
+            11'h379: pattern = 23'h010801;
+            11'h37a: pattern = 23'h010802;
+            11'h37b: pattern = 23'h244000;
+            11'h37c: pattern = 23'h010804;
+            11'h37d: pattern = 23'h081080;
+            11'h37e: pattern = 23'h020048;
+            11'h37f: pattern = 23'h002210;
+            11'h380: pattern = 23'h000380;
+            11'h381: pattern = 23'h421000;
+            11'h382: pattern = 23'h304000;
+            11'h383: pattern = 23'h088004;
+            11'h384: pattern = 23'h002808;
+            11'h385: pattern = 23'h088002;
+            11'h386: pattern = 23'h088001;
+            11'h387: pattern = 23'h088000;
+            11'h388: pattern = 23'h002804;
+            11'h389: pattern = 23'h200440;
+            11'h38a: pattern = 23'h041020;
+            11'h38b: pattern = 23'h010210;
+            11'h38c: pattern = 23'h002800;
+            11'h38d: pattern = 23'h002801;
+            11'h38e: pattern = 23'h002802;
+            11'h38f: pattern = 23'h088008;
+            11'h390: pattern = 23'h048040;
+            11'h391: pattern = 23'h006020;
+            11'h392: pattern = 23'h400c00;
+            11'h393: pattern = 23'h010208;
+            11'h394: pattern = 23'h211000;
+            11'h395: pattern = 23'h100500;
+            11'h396: pattern = 23'h0200a0;
+            11'h397: pattern = 23'h088010;
+            11'h398: pattern = 23'h1a0000;
+            11'h399: pattern = 23'h010202;
+            11'h39a: pattern = 23'h010201;
+            11'h39b: pattern = 23'h010200;
+            11'h39c: pattern = 23'h002810;
+            11'h39d: pattern = 23'h440080;
+            11'h39e: pattern = 23'h004140;
+            11'h39f: pattern = 23'h010204;
+            11'h3a0: pattern = 23'h090400;
+            11'h3a1: pattern = 23'h006010;
+            11'h3a2: pattern = 23'h041008;
+            11'h3a3: pattern = 23'h000940;
+            11'h3a4: pattern = 23'h500040;
+            11'h3a5: pattern = 23'h240200;
+            11'h3a6: pattern = 23'h020090;
+            11'h3a7: pattern = 23'h088020;
+            11'h3a8: pattern = 23'h041002;
+            11'h3a9: pattern = 23'h108080;
+            11'h3aa: pattern = 23'h041000;
+            11'h3ab: pattern = 23'h041001;
+            11'h3ac: pattern = 23'h002820;
+            11'h3ad: pattern = 23'h030100;
+            11'h3ae: pattern = 23'h041004;
+            11'h3af: pattern = 23'h404400;
+            11'h3b0: pattern = 23'h006001;
+            11'h3b1: pattern = 23'h006000;
+            11'h3b2: pattern = 23'h020084;
+            11'h3b3: pattern = 23'h006002;
+            11'h3b4: pattern = 23'h020082;
+            11'h3b5: pattern = 23'h006004;
+            11'h3b6: pattern = 23'h020080;
+            11'h3b7: pattern = 23'h020081;
+            11'h3b8: pattern = 23'h600100;
+            11'h3b9: pattern = 23'h006008;
+            11'h3ba: pattern = 23'h041010;
+            11'h3bb: pattern = 23'h010220;
+            11'h3bc: pattern = 23'h008600;
+            11'h3bd: pattern = 23'h081040;
+            11'h3be: pattern = 23'h020088;
+            11'h3bf: pattern = 23'h300800;
+            11'h3c0: pattern = 23'h048010;
+            11'h3c1: pattern = 23'h200408;
+            11'h3c2: pattern = 23'h032000;
+            11'h3c3: pattern = 23'h000920;
+            11'h3c4: pattern = 23'h500020;
+            11'h3c5: pattern = 23'h014080;
+            11'h3c6: pattern = 23'h001600;
+            11'h3c7: pattern = 23'h088040;
+            11'h3c8: pattern = 23'h200401;
+            11'h3c9: pattern = 23'h200400;
+            11'h3ca: pattern = 23'h480080;
+            11'h3cb: pattern = 23'h200402;
+            11'h3cc: pattern = 23'h002840;
+            11'h3cd: pattern = 23'h200404;
+            11'h3ce: pattern = 23'h004110;
+            11'h3cf: pattern = 23'h160000;
+            11'h3d0: pattern = 23'h048000;
+            11'h3d1: pattern = 23'h048001;
+            11'h3d2: pattern = 23'h048002;
+            11'h3d3: pattern = 23'h101080;
+            11'h3d4: pattern = 23'h048004;
+            11'h3d5: pattern = 23'h020a00;
+            11'h3d6: pattern = 23'h004108;
+            11'h3d7: pattern = 23'h602000;
+            11'h3d8: pattern = 23'h048008;
+            11'h3d9: pattern = 23'h200410;
+            11'h3da: pattern = 23'h004104;
+            11'h3db: pattern = 23'h010240;
+            11'h3dc: pattern = 23'h004102;
+            11'h3dd: pattern = 23'h081020;
+            11'h3de: pattern = 23'h004100;
+            11'h3df: pattern = 23'h004101;
+            11'h3e0: pattern = 23'h500004;
+            11'h3e1: pattern = 23'h000902;
+            11'h3e2: pattern = 23'h000901;
+            11'h3e3: pattern = 23'h000900;
+            11'h3e4: pattern = 23'h500000;
+            11'h3e5: pattern = 23'h500001;
+            11'h3e6: pattern = 23'h500002;
+            11'h3e7: pattern = 23'h000904;
+            11'h3e8: pattern = 23'h024200;
+            11'h3e9: pattern = 23'h200420;
+            11'h3ea: pattern = 23'h041040;
+            11'h3eb: pattern = 23'h000908;
+            11'h3ec: pattern = 23'h500008;
+            11'h3ed: pattern = 23'h081010;
+            11'h3ee: pattern = 23'h218000;
+            11'h3ef: pattern = 23'h002280;
+            11'h3f0: pattern = 23'h048020;
+            11'h3f1: pattern = 23'h006040;
+            11'h3f2: pattern = 23'h280200;
+            11'h3f3: pattern = 23'h000910;
+            11'h3f4: pattern = 23'h500010;
+            11'h3f5: pattern = 23'h081008;
+            11'h3f6: pattern = 23'h0200c0;
+            11'h3f7: pattern = 23'h050400;
+            11'h3f8: pattern = 23'h010880;
+            11'h3f9: pattern = 23'h081004;
+            11'h3fa: pattern = 23'h102400;
+            11'h3fb: pattern = 23'h428000;
+            11'h3fc: pattern = 23'h081001;
+            11'h3fd: pattern = 23'h081000;
+            11'h3fe: pattern = 23'h004120;
+            11'h3ff: pattern = 23'h081002;
+            11'h400: pattern = 23'h000400;
+            11'h401: pattern = 23'h000401;
+            11'h402: pattern = 23'h000402;
+            11'h403: pattern = 23'h000403;
+            11'h404: pattern = 23'h000404;
+            11'h405: pattern = 23'h000405;
+            11'h406: pattern = 23'h000406;
+            11'h407: pattern = 23'h018020;
+            11'h408: pattern = 23'h000408;
+            11'h409: pattern = 23'h000409;
+            11'h40a: pattern = 23'h00040a;
+            11'h40b: pattern = 23'h104040;
+            11'h40c: pattern = 23'h00040c;
+            11'h40d: pattern = 23'h0a0100;
+            11'h40e: pattern = 23'h402010;
+            11'h40f: pattern = 23'h040880;
+            11'h410: pattern = 23'h000410;
+            11'h411: pattern = 23'h000411;
+            11'h412: pattern = 23'h000412;
+            11'h413: pattern = 23'h021800;
+            11'h414: pattern = 23'h000414;
+            11'h415: pattern = 23'h100280;
+            11'h416: pattern = 23'h402008;
+            11'h417: pattern = 23'h204100;
+            11'h418: pattern = 23'h000418;
+            11'h419: pattern = 23'h248000;
+            11'h41a: pattern = 23'h402004;
+            11'h41b: pattern = 23'h080220;
+            11'h41c: pattern = 23'h402002;
+            11'h41d: pattern = 23'h011040;
+            11'h41e: pattern = 23'h402000;
+            11'h41f: pattern = 23'h402001;
+            11'h420: pattern = 23'h000420;
+            11'h421: pattern = 23'h000421;
+            11'h422: pattern = 23'h000422;
+            11'h423: pattern = 23'h018004;
+            11'h424: pattern = 23'h000424;
+            11'h425: pattern = 23'h018002;
+            11'h426: pattern = 23'h018001;
+            11'h427: pattern = 23'h018000;
+            11'h428: pattern = 23'h000428;
+            11'h429: pattern = 23'h003080;
+            11'h42a: pattern = 23'h200900;
+            11'h42b: pattern = 23'h080210;
+            11'h42c: pattern = 23'h040240;
+            11'h42d: pattern = 23'h700000;
+            11'h42e: pattern = 23'h025000;
+            11'h42f: pattern = 23'h018008;
+            11'h430: pattern = 23'h000430;
+            11'h431: pattern = 23'h400140;
+            11'h432: pattern = 23'h044080;
+            11'h433: pattern = 23'h080208;
+            11'h434: pattern = 23'h281000;
+            11'h435: pattern = 23'h062000;
+            11'h436: pattern = 23'h100840;
+            11'h437: pattern = 23'h018010;
+            11'h438: pattern = 23'h130000;
+            11'h439: pattern = 23'h080202;
+            11'h43a: pattern = 23'h080201;
+            11'h43b: pattern = 23'h080200;
+            11'h43c: pattern = 23'h008180;
+            11'h43d: pattern = 23'h004c00;
+            11'h43e: pattern = 23'h402020;
+            11'h43f: pattern = 23'h080204;
+            11'h440: pattern = 23'h000440;
+            11'h441: pattern = 23'h000441;
+            11'h442: pattern = 23'h000442;
+            11'h443: pattern = 23'h104008;
+            11'h444: pattern = 23'h000444;
+            11'h445: pattern = 23'h202800;
+            11'h446: pattern = 23'h001180;
+            11'h447: pattern = 23'h420200;
+            11'h448: pattern = 23'h000448;
+            11'h449: pattern = 23'h104002;
+            11'h44a: pattern = 23'h104001;
+            11'h44b: pattern = 23'h104000;
+            11'h44c: pattern = 23'h040220;
+            11'h44d: pattern = 23'h011010;
+            11'h44e: pattern = 23'h288000;
+            11'h44f: pattern = 23'h104004;
+            11'h450: pattern = 23'h000450;
+            11'h451: pattern = 23'h400120;
+            11'h452: pattern = 23'h210200;
+            11'h453: pattern = 23'h00a080;
+            11'h454: pattern = 23'h02c000;
+            11'h455: pattern = 23'h011008;
+            11'h456: pattern = 23'h100820;
+            11'h457: pattern = 23'h0c0400;
+            11'h458: pattern = 23'h080880;
+            11'h459: pattern = 23'h011004;
+            11'h45a: pattern = 23'h060100;
+            11'h45b: pattern = 23'h104010;
+            11'h45c: pattern = 23'h011001;
+            11'h45d: pattern = 23'h011000;
+            11'h45e: pattern = 23'h402040;
+            11'h45f: pattern = 23'h011002;
+            11'h460: pattern = 23'h000460;
+            11'h461: pattern = 23'h400110;
+            11'h462: pattern = 23'h0a2000;
+            11'h463: pattern = 23'h241000;
+            11'h464: pattern = 23'h040208;
+            11'h465: pattern = 23'h084080;
+            11'h466: pattern = 23'h100810;
+            11'h467: pattern = 23'h018040;
+            11'h468: pattern = 23'h040204;
+            11'h469: pattern = 23'h028800;
+            11'h46a: pattern = 23'h410080;
+            11'h46b: pattern = 23'h104020;
+            11'h46c: pattern = 23'h040200;
+            11'h46d: pattern = 23'h040201;
+            11'h46e: pattern = 23'h040202;
+            11'h46f: pattern = 23'h002500;
+            11'h470: pattern = 23'h400101;
+            11'h471: pattern = 23'h400100;
+            11'h472: pattern = 23'h100804;
+            11'h473: pattern = 23'h400102;
+            11'h474: pattern = 23'h100802;
+            11'h475: pattern = 23'h400104;
+            11'h476: pattern = 23'h100800;
+            11'h477: pattern = 23'h100801;
+            11'h478: pattern = 23'h206000;
+            11'h479: pattern = 23'h400108;
+            11'h47a: pattern = 23'h009400;
+            11'h47b: pattern = 23'h080240;
+            11'h47c: pattern = 23'h040210;
+            11'h47d: pattern = 23'h011020;
+            11'h47e: pattern = 23'h100808;
+            11'h47f: pattern = 23'h220080;
+            11'h480: pattern = 23'h000480;
+            11'h481: pattern = 23'h000481;
+            11'h482: pattern = 23'h000482;
+            11'h483: pattern = 23'h680000;
+            11'h484: pattern = 23'h000484;
+            11'h485: pattern = 23'h100210;
+            11'h486: pattern = 23'h001140;
+            11'h487: pattern = 23'h040808;
+            11'h488: pattern = 23'h000488;
+            11'h489: pattern = 23'h003020;
+            11'h48a: pattern = 23'h028200;
+            11'h48b: pattern = 23'h040804;
+            11'h48c: pattern = 23'h214000;
+            11'h48d: pattern = 23'h040802;
+            11'h48e: pattern = 23'h040801;
+            11'h48f: pattern = 23'h040800;
+            11'h490: pattern = 23'h000490;
+            11'h491: pattern = 23'h100204;
+            11'h492: pattern = 23'h044020;
+            11'h493: pattern = 23'h00a040;
+            11'h494: pattern = 23'h100201;
+            11'h495: pattern = 23'h100200;
+            11'h496: pattern = 23'h0b0000;
+            11'h497: pattern = 23'h100202;
+            11'h498: pattern = 23'h080840;
+            11'h499: pattern = 23'h424000;
+            11'h49a: pattern = 23'h301000;
+            11'h49b: pattern = 23'h010500;
+            11'h49c: pattern = 23'h008120;
+            11'h49d: pattern = 23'h100208;
+            11'h49e: pattern = 23'h402080;
+            11'h49f: pattern = 23'h040810;
+            11'h4a0: pattern = 23'h0004a0;
+            11'h4a1: pattern = 23'h003008;
+            11'h4a2: pattern = 23'h044010;
+            11'h4a3: pattern = 23'h120100;
+            11'h4a4: pattern = 23'h420800;
+            11'h4a5: pattern = 23'h084040;
+            11'h4a6: pattern = 23'h202200;
+            11'h4a7: pattern = 23'h018080;
+            11'h4a8: pattern = 23'h003001;
+            11'h4a9: pattern = 23'h003000;
+            11'h4aa: pattern = 23'h410040;
+            11'h4ab: pattern = 23'h003002;
+            11'h4ac: pattern = 23'h008110;
+            11'h4ad: pattern = 23'h003004;
+            11'h4ae: pattern = 23'h180400;
+            11'h4af: pattern = 23'h040820;
+            11'h4b0: pattern = 23'h044002;
+            11'h4b1: pattern = 23'h210800;
+            11'h4b2: pattern = 23'h044000;
+            11'h4b3: pattern = 23'h044001;
+            11'h4b4: pattern = 23'h008108;
+            11'h4b5: pattern = 23'h100220;
+            11'h4b6: pattern = 23'h044004;
+            11'h4b7: pattern = 23'h401400;
+            11'h4b8: pattern = 23'h008104;
+            11'h4b9: pattern = 23'h003010;
+            11'h4ba: pattern = 23'h044008;
+            11'h4bb: pattern = 23'h080280;
+            11'h4bc: pattern = 23'h008100;
+            11'h4bd: pattern = 23'h008101;
+            11'h4be: pattern = 23'h008102;
+            11'h4bf: pattern = 23'h220040;
+            11'h4c0: pattern = 23'h0004c0;
+            11'h4c1: pattern = 23'h070000;
+            11'h4c2: pattern = 23'h001104;
+            11'h4c3: pattern = 23'h00a010;
+            11'h4c4: pattern = 23'h001102;
+            11'h4c5: pattern = 23'h084020;
+            11'h4c6: pattern = 23'h001100;
+            11'h4c7: pattern = 23'h001101;
+            11'h4c8: pattern = 23'h080810;
+            11'h4c9: pattern = 23'h200300;
+            11'h4ca: pattern = 23'h410020;
+            11'h4cb: pattern = 23'h104080;
+            11'h4cc: pattern = 23'h122000;
+            11'h4cd: pattern = 23'h408400;
+            11'h4ce: pattern = 23'h001108;
+            11'h4cf: pattern = 23'h040840;
+            11'h4d0: pattern = 23'h080808;
+            11'h4d1: pattern = 23'h00a002;
+            11'h4d2: pattern = 23'h00a001;
+            11'h4d3: pattern = 23'h00a000;
+            11'h4d4: pattern = 23'h640000;
+            11'h4d5: pattern = 23'h100240;
+            11'h4d6: pattern = 23'h001110;
+            11'h4d7: pattern = 23'h00a004;
+            11'h4d8: pattern = 23'h080800;
+            11'h4d9: pattern = 23'h080801;
+            11'h4da: pattern = 23'h080802;
+            11'h4db: pattern = 23'h00a008;
+            11'h4dc: pattern = 23'h080804;
+            11'h4dd: pattern = 23'h011080;
+            11'h4de: pattern = 23'h004600;
+            11'h4df: pattern = 23'h220020;
+            11'h4e0: pattern = 23'h308000;
+            11'h4e1: pattern = 23'h084004;
+            11'h4e2: pattern = 23'h410008;
+            11'h4e3: pattern = 23'h000e00;
+            11'h4e4: pattern = 23'h084001;
+            11'h4e5: pattern = 23'h084000;
+            11'h4e6: pattern = 23'h001120;
+            11'h4e7: pattern = 23'h084002;
+            11'h4e8: pattern = 23'h410002;
+            11'h4e9: pattern = 23'h003040;
+            11'h4ea: pattern = 23'h410000;
+            11'h4eb: pattern = 23'h410001;
+            11'h4ec: pattern = 23'h040280;
+            11'h4ed: pattern = 23'h084008;
+            11'h4ee: pattern = 23'h410004;
+            11'h4ef: pattern = 23'h220010;
+            11'h4f0: pattern = 23'h021200;
+            11'h4f1: pattern = 23'h400180;
+            11'h4f2: pattern = 23'h044040;
+            11'h4f3: pattern = 23'h00a020;
+            11'h4f4: pattern = 23'h012400;
+            11'h4f5: pattern = 23'h084010;
+            11'h4f6: pattern = 23'h100880;
+            11'h4f7: pattern = 23'h220008;
+            11'h4f8: pattern = 23'h080820;
+            11'h4f9: pattern = 23'h140400;
+            11'h4fa: pattern = 23'h410010;
+            11'h4fb: pattern = 23'h220004;
+            11'h4fc: pattern = 23'h008140;
+            11'h4fd: pattern = 23'h220002;
+            11'h4fe: pattern = 23'h220001;
+            11'h4ff: pattern = 23'h220000;
+            11'h500: pattern = 23'h000500;
+            11'h501: pattern = 23'h000501;
+            11'h502: pattern = 23'h000502;
+            11'h503: pattern = 23'h042200;
+            11'h504: pattern = 23'h000504;
+            11'h505: pattern = 23'h0a0008;
+            11'h506: pattern = 23'h0010c0;
+            11'h507: pattern = 23'h204010;
+            11'h508: pattern = 23'h000508;
+            11'h509: pattern = 23'h0a0004;
+            11'h50a: pattern = 23'h200820;
+            11'h50b: pattern = 23'h409000;
+            11'h50c: pattern = 23'h0a0001;
+            11'h50d: pattern = 23'h0a0000;
+            11'h50e: pattern = 23'h110200;
+            11'h50f: pattern = 23'h0a0002;
+            11'h510: pattern = 23'h000510;
+            11'h511: pattern = 23'h400060;
+            11'h512: pattern = 23'h188000;
+            11'h513: pattern = 23'h204004;
+            11'h514: pattern = 23'h050800;
+            11'h515: pattern = 23'h204002;
+            11'h516: pattern = 23'h204001;
+            11'h517: pattern = 23'h204000;
+            11'h518: pattern = 23'h005200;
+            11'h519: pattern = 23'h102800;
+            11'h51a: pattern = 23'h060040;
+            11'h51b: pattern = 23'h010480;
+            11'h51c: pattern = 23'h0080a0;
+            11'h51d: pattern = 23'h0a0010;
+            11'h51e: pattern = 23'h402100;
+            11'h51f: pattern = 23'h204008;
+            11'h520: pattern = 23'h000520;
+            11'h521: pattern = 23'h400050;
+            11'h522: pattern = 23'h200808;
+            11'h523: pattern = 23'h120080;
+            11'h524: pattern = 23'h106000;
+            11'h525: pattern = 23'h001a00;
+            11'h526: pattern = 23'h4c0000;
+            11'h527: pattern = 23'h018100;
+            11'h528: pattern = 23'h200802;
+            11'h529: pattern = 23'h054000;
+            11'h52a: pattern = 23'h200800;
+            11'h52b: pattern = 23'h200801;
+            11'h52c: pattern = 23'h008090;
+            11'h52d: pattern = 23'h0a0020;
+            11'h52e: pattern = 23'h200804;
+            11'h52f: pattern = 23'h002440;
+            11'h530: pattern = 23'h400041;
+            11'h531: pattern = 23'h400040;
+            11'h532: pattern = 23'h013000;
+            11'h533: pattern = 23'h400042;
+            11'h534: pattern = 23'h008088;
+            11'h535: pattern = 23'h400044;
+            11'h536: pattern = 23'h020600;
+            11'h537: pattern = 23'h204020;
+            11'h538: pattern = 23'h008084;
+            11'h539: pattern = 23'h400048;
+            11'h53a: pattern = 23'h200810;
+            11'h53b: pattern = 23'h080300;
+            11'h53c: pattern = 23'h008080;
+            11'h53d: pattern = 23'h008081;
+            11'h53e: pattern = 23'h008082;
+            11'h53f: pattern = 23'h141000;
+            11'h540: pattern = 23'h000540;
+            11'h541: pattern = 23'h400030;
+            11'h542: pattern = 23'h001084;
+            11'h543: pattern = 23'h090800;
+            11'h544: pattern = 23'h001082;
+            11'h545: pattern = 23'h148000;
+            11'h546: pattern = 23'h001080;
+            11'h547: pattern = 23'h001081;
+            11'h548: pattern = 23'h01a000;
+            11'h549: pattern = 23'h200280;
+            11'h54a: pattern = 23'h060010;
+            11'h54b: pattern = 23'h104100;
+            11'h54c: pattern = 23'h404800;
+            11'h54d: pattern = 23'h0a0040;
+            11'h54e: pattern = 23'h001088;
+            11'h54f: pattern = 23'h002420;
+            11'h550: pattern = 23'h400021;
+            11'h551: pattern = 23'h400020;
+            11'h552: pattern = 23'h060008;
+            11'h553: pattern = 23'h400022;
+            11'h554: pattern = 23'h082200;
+            11'h555: pattern = 23'h400024;
+            11'h556: pattern = 23'h001090;
+            11'h557: pattern = 23'h204040;
+            11'h558: pattern = 23'h060002;
+            11'h559: pattern = 23'h400028;
+            11'h55a: pattern = 23'h060000;
+            11'h55b: pattern = 23'h060001;
+            11'h55c: pattern = 23'h300400;
+            11'h55d: pattern = 23'h011100;
+            11'h55e: pattern = 23'h060004;
+            11'h55f: pattern = 23'h008a00;
+            11'h560: pattern = 23'h400011;
+            11'h561: pattern = 23'h400010;
+            11'h562: pattern = 23'h00c200;
+            11'h563: pattern = 23'h400012;
+            11'h564: pattern = 23'h230000;
+            11'h565: pattern = 23'h400014;
+            11'h566: pattern = 23'h0010a0;
+            11'h567: pattern = 23'h002408;
+            11'h568: pattern = 23'h181000;
+            11'h569: pattern = 23'h400018;
+            11'h56a: pattern = 23'h200840;
+            11'h56b: pattern = 23'h002404;
+            11'h56c: pattern = 23'h040300;
+            11'h56d: pattern = 23'h002402;
+            11'h56e: pattern = 23'h002401;
+            11'h56f: pattern = 23'h002400;
+            11'h570: pattern = 23'h400001;
+            11'h571: pattern = 23'h400000;
+            11'h572: pattern = 23'h400003;
+            11'h573: pattern = 23'h400002;
+            11'h574: pattern = 23'h400005;
+            11'h575: pattern = 23'h400004;
+            11'h576: pattern = 23'h100900;
+            11'h577: pattern = 23'h400006;
+            11'h578: pattern = 23'h400009;
+            11'h579: pattern = 23'h400008;
+            11'h57a: pattern = 23'h060020;
+            11'h57b: pattern = 23'h40000a;
+            11'h57c: pattern = 23'h0080c0;
+            11'h57d: pattern = 23'h40000c;
+            11'h57e: pattern = 23'h094000;
+            11'h57f: pattern = 23'h002410;
+            11'h580: pattern = 23'h000580;
+            11'h581: pattern = 23'h00c800;
+            11'h582: pattern = 23'h001044;
+            11'h583: pattern = 23'h120020;
+            11'h584: pattern = 23'h001042;
+            11'h585: pattern = 23'h412000;
+            11'h586: pattern = 23'h001040;
+            11'h587: pattern = 23'h001041;
+            11'h588: pattern = 23'h540000;
+            11'h589: pattern = 23'h200240;
+            11'h58a: pattern = 23'h086000;
+            11'h58b: pattern = 23'h010410;
+            11'h58c: pattern = 23'h008030;
+            11'h58d: pattern = 23'h0a0080;
+            11'h58e: pattern = 23'h001048;
+            11'h58f: pattern = 23'h040900;
+            11'h590: pattern = 23'h222000;
+            11'h591: pattern = 23'h0c1000;
+            11'h592: pattern = 23'h400a00;
+            11'h593: pattern = 23'h010408;
+            11'h594: pattern = 23'h008028;
+            11'h595: pattern = 23'h100300;
+            11'h596: pattern = 23'h001050;
+            11'h597: pattern = 23'h204080;
+            11'h598: pattern = 23'h008024;
+            11'h599: pattern = 23'h010402;
+            11'h59a: pattern = 23'h010401;
+            11'h59b: pattern = 23'h010400;
+            11'h59c: pattern = 23'h008020;
+            11'h59d: pattern = 23'h008021;
+            11'h59e: pattern = 23'h008022;
+            11'h59f: pattern = 23'h010404;
+            11'h5a0: pattern = 23'h090200;
+            11'h5a1: pattern = 23'h120002;
+            11'h5a2: pattern = 23'h120001;
+            11'h5a3: pattern = 23'h120000;
+            11'h5a4: pattern = 23'h008018;
+            11'h5a5: pattern = 23'h240400;
+            11'h5a6: pattern = 23'h001060;
+            11'h5a7: pattern = 23'h120004;
+            11'h5a8: pattern = 23'h008014;
+            11'h5a9: pattern = 23'h003100;
+            11'h5aa: pattern = 23'h200880;
+            11'h5ab: pattern = 23'h120008;
+            11'h5ac: pattern = 23'h008010;
+            11'h5ad: pattern = 23'h008011;
+            11'h5ae: pattern = 23'h008012;
+            11'h5af: pattern = 23'h404200;
+            11'h5b0: pattern = 23'h00800c;
+            11'h5b1: pattern = 23'h4000c0;
+            11'h5b2: pattern = 23'h044100;
+            11'h5b3: pattern = 23'h120010;
+            11'h5b4: pattern = 23'h008008;
+            11'h5b5: pattern = 23'h008009;
+            11'h5b6: pattern = 23'h00800a;
+            11'h5b7: pattern = 23'h082800;
+            11'h5b8: pattern = 23'h008004;
+            11'h5b9: pattern = 23'h008005;
+            11'h5ba: pattern = 23'h008006;
+            11'h5bb: pattern = 23'h010420;
+            11'h5bc: pattern = 23'h008000;
+            11'h5bd: pattern = 23'h008001;
+            11'h5be: pattern = 23'h008002;
+            11'h5bf: pattern = 23'h008003;
+            11'h5c0: pattern = 23'h001006;
+            11'h5c1: pattern = 23'h200208;
+            11'h5c2: pattern = 23'h001004;
+            11'h5c3: pattern = 23'h001005;
+            11'h5c4: pattern = 23'h001002;
+            11'h5c5: pattern = 23'h001003;
+            11'h5c6: pattern = 23'h001000;
+            11'h5c7: pattern = 23'h001001;
+            11'h5c8: pattern = 23'h200201;
+            11'h5c9: pattern = 23'h200200;
+            11'h5ca: pattern = 23'h00100c;
+            11'h5cb: pattern = 23'h200202;
+            11'h5cc: pattern = 23'h00100a;
+            11'h5cd: pattern = 23'h200204;
+            11'h5ce: pattern = 23'h001008;
+            11'h5cf: pattern = 23'h001009;
+            11'h5d0: pattern = 23'h114000;
+            11'h5d1: pattern = 23'h4000a0;
+            11'h5d2: pattern = 23'h001014;
+            11'h5d3: pattern = 23'h00a100;
+            11'h5d4: pattern = 23'h001012;
+            11'h5d5: pattern = 23'h020c00;
+            11'h5d6: pattern = 23'h001010;
+            11'h5d7: pattern = 23'h001011;
+            11'h5d8: pattern = 23'h080900;
+            11'h5d9: pattern = 23'h200210;
+            11'h5da: pattern = 23'h060080;
+            11'h5db: pattern = 23'h010440;
+            11'h5dc: pattern = 23'h008060;
+            11'h5dd: pattern = 23'h046000;
+            11'h5de: pattern = 23'h001018;
+            11'h5df: pattern = 23'h580000;
+            11'h5e0: pattern = 23'h042800;
+            11'h5e1: pattern = 23'h400090;
+            11'h5e2: pattern = 23'h001024;
+            11'h5e3: pattern = 23'h120040;
+            11'h5e4: pattern = 23'h001022;
+            11'h5e5: pattern = 23'h084100;
+            11'h5e6: pattern = 23'h001020;
+            11'h5e7: pattern = 23'h001021;
+            11'h5e8: pattern = 23'h024400;
+            11'h5e9: pattern = 23'h200220;
+            11'h5ea: pattern = 23'h410100;
+            11'h5eb: pattern = 23'h0c8000;
+            11'h5ec: pattern = 23'h008050;
+            11'h5ed: pattern = 23'h110800;
+            11'h5ee: pattern = 23'h001028;
+            11'h5ef: pattern = 23'h002480;
+            11'h5f0: pattern = 23'h400081;
+            11'h5f1: pattern = 23'h400080;
+            11'h5f2: pattern = 23'h280400;
+            11'h5f3: pattern = 23'h400082;
+            11'h5f4: pattern = 23'h008048;
+            11'h5f5: pattern = 23'h400084;
+            11'h5f6: pattern = 23'h001030;
+            11'h5f7: pattern = 23'h050200;
+            11'h5f8: pattern = 23'h008044;
+            11'h5f9: pattern = 23'h400088;
+            11'h5fa: pattern = 23'h102200;
+            11'h5fb: pattern = 23'h005800;
+            11'h5fc: pattern = 23'h008040;
+            11'h5fd: pattern = 23'h008041;
+            11'h5fe: pattern = 23'h008042;
+            11'h5ff: pattern = 23'h220100;
+            11'h600: pattern = 23'h000600;
+            11'h601: pattern = 23'h000601;
+            11'h602: pattern = 23'h000602;
+            11'h603: pattern = 23'h042100;
+            11'h604: pattern = 23'h000604;
+            11'h605: pattern = 23'h100090;
+            11'h606: pattern = 23'h084800;
+            11'h607: pattern = 23'h420040;
+            11'h608: pattern = 23'h000608;
+            11'h609: pattern = 23'h410800;
+            11'h60a: pattern = 23'h028080;
+            11'h60b: pattern = 23'h080030;
+            11'h60c: pattern = 23'h040060;
+            11'h60d: pattern = 23'h00e000;
+            11'h60e: pattern = 23'h110100;
+            11'h60f: pattern = 23'h201400;
+            11'h610: pattern = 23'h000610;
+            11'h611: pattern = 23'h100084;
+            11'h612: pattern = 23'h210040;
+            11'h613: pattern = 23'h080028;
+            11'h614: pattern = 23'h100081;
+            11'h615: pattern = 23'h100080;
+            11'h616: pattern = 23'h049000;
+            11'h617: pattern = 23'h100082;
+            11'h618: pattern = 23'h005100;
+            11'h619: pattern = 23'h080022;
+            11'h61a: pattern = 23'h080021;
+            11'h61b: pattern = 23'h080020;
+            11'h61c: pattern = 23'h220800;
+            11'h61d: pattern = 23'h100088;
+            11'h61e: pattern = 23'h402200;
+            11'h61f: pattern = 23'h080024;
+            11'h620: pattern = 23'h000620;
+            11'h621: pattern = 23'h224000;
+            11'h622: pattern = 23'h501000;
+            11'h623: pattern = 23'h080018;
+            11'h624: pattern = 23'h040048;
+            11'h625: pattern = 23'h001900;
+            11'h626: pattern = 23'h202080;
+            11'h627: pattern = 23'h018200;
+            11'h628: pattern = 23'h040044;
+            11'h629: pattern = 23'h080012;
+            11'h62a: pattern = 23'h080011;
+            11'h62b: pattern = 23'h080010;
+            11'h62c: pattern = 23'h040040;
+            11'h62d: pattern = 23'h040041;
+            11'h62e: pattern = 23'h040042;
+            11'h62f: pattern = 23'h080014;
+            11'h630: pattern = 23'h00a800;
+            11'h631: pattern = 23'h08000a;
+            11'h632: pattern = 23'h080009;
+            11'h633: pattern = 23'h080008;
+            11'h634: pattern = 23'h414000;
+            11'h635: pattern = 23'h1000a0;
+            11'h636: pattern = 23'h020500;
+            11'h637: pattern = 23'h08000c;
+            11'h638: pattern = 23'h080003;
+            11'h639: pattern = 23'h080002;
+            11'h63a: pattern = 23'h080001;
+            11'h63b: pattern = 23'h080000;
+            11'h63c: pattern = 23'h040050;
+            11'h63d: pattern = 23'h080006;
+            11'h63e: pattern = 23'h080005;
+            11'h63f: pattern = 23'h080004;
+            11'h640: pattern = 23'h000640;
+            11'h641: pattern = 23'h089000;
+            11'h642: pattern = 23'h210010;
+            11'h643: pattern = 23'h420004;
+            11'h644: pattern = 23'h040028;
+            11'h645: pattern = 23'h420002;
+            11'h646: pattern = 23'h420001;
+            11'h647: pattern = 23'h420000;
+            11'h648: pattern = 23'h040024;
+            11'h649: pattern = 23'h200180;
+            11'h64a: pattern = 23'h003800;
+            11'h64b: pattern = 23'h104200;
+            11'h64c: pattern = 23'h040020;
+            11'h64d: pattern = 23'h040021;
+            11'h64e: pattern = 23'h040022;
+            11'h64f: pattern = 23'h420008;
+            11'h650: pattern = 23'h210002;
+            11'h651: pattern = 23'h044800;
+            11'h652: pattern = 23'h210000;
+            11'h653: pattern = 23'h210001;
+            11'h654: pattern = 23'h082100;
+            11'h655: pattern = 23'h1000c0;
+            11'h656: pattern = 23'h210004;
+            11'h657: pattern = 23'h420010;
+            11'h658: pattern = 23'h508000;
+            11'h659: pattern = 23'h022400;
+            11'h65a: pattern = 23'h210008;
+            11'h65b: pattern = 23'h080060;
+            11'h65c: pattern = 23'h040030;
+            11'h65d: pattern = 23'h011200;
+            11'h65e: pattern = 23'h004480;
+            11'h65f: pattern = 23'h008900;
+            11'h660: pattern = 23'h04000c;
+            11'h661: pattern = 23'h112000;
+            11'h662: pattern = 23'h00c100;
+            11'h663: pattern = 23'h000c80;
+            11'h664: pattern = 23'h040008;
+            11'h665: pattern = 23'h040009;
+            11'h666: pattern = 23'h04000a;
+            11'h667: pattern = 23'h420020;
+            11'h668: pattern = 23'h040004;
+            11'h669: pattern = 23'h040005;
+            11'h66a: pattern = 23'h040006;
+            11'h66b: pattern = 23'h080050;
+            11'h66c: pattern = 23'h040000;
+            11'h66d: pattern = 23'h040001;
+            11'h66e: pattern = 23'h040002;
+            11'h66f: pattern = 23'h040003;
+            11'h670: pattern = 23'h021080;
+            11'h671: pattern = 23'h400300;
+            11'h672: pattern = 23'h210020;
+            11'h673: pattern = 23'h080048;
+            11'h674: pattern = 23'h040018;
+            11'h675: pattern = 23'h208400;
+            11'h676: pattern = 23'h100a00;
+            11'h677: pattern = 23'h007000;
+            11'h678: pattern = 23'h040014;
+            11'h679: pattern = 23'h080042;
+            11'h67a: pattern = 23'h080041;
+            11'h67b: pattern = 23'h080040;
+            11'h67c: pattern = 23'h040010;
+            11'h67d: pattern = 23'h040011;
+            11'h67e: pattern = 23'h040012;
+            11'h67f: pattern = 23'h080044;
+            11'h680: pattern = 23'h000680;
+            11'h681: pattern = 23'h100014;
+            11'h682: pattern = 23'h028008;
+            11'h683: pattern = 23'h015000;
+            11'h684: pattern = 23'h100011;
+            11'h685: pattern = 23'h100010;
+            11'h686: pattern = 23'h202020;
+            11'h687: pattern = 23'h100012;
+            11'h688: pattern = 23'h028002;
+            11'h689: pattern = 23'h200140;
+            11'h68a: pattern = 23'h028000;
+            11'h68b: pattern = 23'h028001;
+            11'h68c: pattern = 23'h481000;
+            11'h68d: pattern = 23'h100018;
+            11'h68e: pattern = 23'h028004;
+            11'h68f: pattern = 23'h040a00;
+            11'h690: pattern = 23'h100005;
+            11'h691: pattern = 23'h100004;
+            11'h692: pattern = 23'h400900;
+            11'h693: pattern = 23'h100006;
+            11'h694: pattern = 23'h100001;
+            11'h695: pattern = 23'h100000;
+            11'h696: pattern = 23'h100003;
+            11'h697: pattern = 23'h100002;
+            11'h698: pattern = 23'h052000;
+            11'h699: pattern = 23'h10000c;
+            11'h69a: pattern = 23'h028010;
+            11'h69b: pattern = 23'h0800a0;
+            11'h69c: pattern = 23'h100009;
+            11'h69d: pattern = 23'h100008;
+            11'h69e: pattern = 23'h004440;
+            11'h69f: pattern = 23'h10000a;
+            11'h6a0: pattern = 23'h090100;
+            11'h6a1: pattern = 23'h448000;
+            11'h6a2: pattern = 23'h202004;
+            11'h6a3: pattern = 23'h000c40;
+            11'h6a4: pattern = 23'h202002;
+            11'h6a5: pattern = 23'h100030;
+            11'h6a6: pattern = 23'h202000;
+            11'h6a7: pattern = 23'h202001;
+            11'h6a8: pattern = 23'h104800;
+            11'h6a9: pattern = 23'h003200;
+            11'h6aa: pattern = 23'h028020;
+            11'h6ab: pattern = 23'h080090;
+            11'h6ac: pattern = 23'h0400c0;
+            11'h6ad: pattern = 23'h030400;
+            11'h6ae: pattern = 23'h202008;
+            11'h6af: pattern = 23'h404100;
+            11'h6b0: pattern = 23'h021040;
+            11'h6b1: pattern = 23'h100024;
+            11'h6b2: pattern = 23'h044200;
+            11'h6b3: pattern = 23'h080088;
+            11'h6b4: pattern = 23'h100021;
+            11'h6b5: pattern = 23'h100020;
+            11'h6b6: pattern = 23'h202010;
+            11'h6b7: pattern = 23'h100022;
+            11'h6b8: pattern = 23'h600400;
+            11'h6b9: pattern = 23'h080082;
+            11'h6ba: pattern = 23'h080081;
+            11'h6bb: pattern = 23'h080080;
+            11'h6bc: pattern = 23'h008300;
+            11'h6bd: pattern = 23'h100028;
+            11'h6be: pattern = 23'h011800;
+            11'h6bf: pattern = 23'h080084;
+            11'h6c0: pattern = 23'h406000;
+            11'h6c1: pattern = 23'h200108;
+            11'h6c2: pattern = 23'h1c0000;
+            11'h6c3: pattern = 23'h000c20;
+            11'h6c4: pattern = 23'h018800;
+            11'h6c5: pattern = 23'h100050;
+            11'h6c6: pattern = 23'h001300;
+            11'h6c7: pattern = 23'h420080;
+            11'h6c8: pattern = 23'h200101;
+            11'h6c9: pattern = 23'h200100;
+            11'h6ca: pattern = 23'h028040;
+            11'h6cb: pattern = 23'h200102;
+            11'h6cc: pattern = 23'h0400a0;
+            11'h6cd: pattern = 23'h200104;
+            11'h6ce: pattern = 23'h004410;
+            11'h6cf: pattern = 23'h092000;
+            11'h6d0: pattern = 23'h021020;
+            11'h6d1: pattern = 23'h100044;
+            11'h6d2: pattern = 23'h210080;
+            11'h6d3: pattern = 23'h00a200;
+            11'h6d4: pattern = 23'h100041;
+            11'h6d5: pattern = 23'h100040;
+            11'h6d6: pattern = 23'h004408;
+            11'h6d7: pattern = 23'h100042;
+            11'h6d8: pattern = 23'h080a00;
+            11'h6d9: pattern = 23'h200110;
+            11'h6da: pattern = 23'h004404;
+            11'h6db: pattern = 23'h441000;
+            11'h6dc: pattern = 23'h004402;
+            11'h6dd: pattern = 23'h100048;
+            11'h6de: pattern = 23'h004400;
+            11'h6df: pattern = 23'h004401;
+            11'h6e0: pattern = 23'h021010;
+            11'h6e1: pattern = 23'h000c02;
+            11'h6e2: pattern = 23'h000c01;
+            11'h6e3: pattern = 23'h000c00;
+            11'h6e4: pattern = 23'h040088;
+            11'h6e5: pattern = 23'h084200;
+            11'h6e6: pattern = 23'h202040;
+            11'h6e7: pattern = 23'h000c04;
+            11'h6e8: pattern = 23'h040084;
+            11'h6e9: pattern = 23'h200120;
+            11'h6ea: pattern = 23'h410200;
+            11'h6eb: pattern = 23'h000c08;
+            11'h6ec: pattern = 23'h040080;
+            11'h6ed: pattern = 23'h040081;
+            11'h6ee: pattern = 23'h040082;
+            11'h6ef: pattern = 23'h109000;
+            11'h6f0: pattern = 23'h021000;
+            11'h6f1: pattern = 23'h021001;
+            11'h6f2: pattern = 23'h021002;
+            11'h6f3: pattern = 23'h000c10;
+            11'h6f4: pattern = 23'h021004;
+            11'h6f5: pattern = 23'h100060;
+            11'h6f6: pattern = 23'h488000;
+            11'h6f7: pattern = 23'h050100;
+            11'h6f8: pattern = 23'h021008;
+            11'h6f9: pattern = 23'h01c000;
+            11'h6fa: pattern = 23'h102100;
+            11'h6fb: pattern = 23'h0800c0;
+            11'h6fc: pattern = 23'h040090;
+            11'h6fd: pattern = 23'h402800;
+            11'h6fe: pattern = 23'h004420;
+            11'h6ff: pattern = 23'h220200;
+            11'h700: pattern = 23'h000700;
+            11'h701: pattern = 23'h042002;
+            11'h702: pattern = 23'h042001;
+            11'h703: pattern = 23'h042000;
+            11'h704: pattern = 23'h608000;
+            11'h705: pattern = 23'h001820;
+            11'h706: pattern = 23'h110008;
+            11'h707: pattern = 23'h042004;
+            11'h708: pattern = 23'h005010;
+            11'h709: pattern = 23'h2000c0;
+            11'h70a: pattern = 23'h110004;
+            11'h70b: pattern = 23'h042008;
+            11'h70c: pattern = 23'h110002;
+            11'h70d: pattern = 23'h0a0200;
+            11'h70e: pattern = 23'h110000;
+            11'h70f: pattern = 23'h110001;
+            11'h710: pattern = 23'h005008;
+            11'h711: pattern = 23'h038000;
+            11'h712: pattern = 23'h400880;
+            11'h713: pattern = 23'h042010;
+            11'h714: pattern = 23'h082040;
+            11'h715: pattern = 23'h100180;
+            11'h716: pattern = 23'h020420;
+            11'h717: pattern = 23'h204200;
+            11'h718: pattern = 23'h005000;
+            11'h719: pattern = 23'h005001;
+            11'h71a: pattern = 23'h005002;
+            11'h71b: pattern = 23'h080120;
+            11'h71c: pattern = 23'h005004;
+            11'h71d: pattern = 23'h440400;
+            11'h71e: pattern = 23'h110010;
+            11'h71f: pattern = 23'h008840;
+            11'h720: pattern = 23'h090080;
+            11'h721: pattern = 23'h001804;
+            11'h722: pattern = 23'h00c040;
+            11'h723: pattern = 23'h042020;
+            11'h724: pattern = 23'h001801;
+            11'h725: pattern = 23'h001800;
+            11'h726: pattern = 23'h020410;
+            11'h727: pattern = 23'h001802;
+            11'h728: pattern = 23'h422000;
+            11'h729: pattern = 23'h108400;
+            11'h72a: pattern = 23'h200a00;
+            11'h72b: pattern = 23'h080110;
+            11'h72c: pattern = 23'h040140;
+            11'h72d: pattern = 23'h001808;
+            11'h72e: pattern = 23'h110020;
+            11'h72f: pattern = 23'h404080;
+            11'h730: pattern = 23'h340000;
+            11'h731: pattern = 23'h400240;
+            11'h732: pattern = 23'h020404;
+            11'h733: pattern = 23'h080108;
+            11'h734: pattern = 23'h020402;
+            11'h735: pattern = 23'h001810;
+            11'h736: pattern = 23'h020400;
+            11'h737: pattern = 23'h020401;
+            11'h738: pattern = 23'h005020;
+            11'h739: pattern = 23'h080102;
+            11'h73a: pattern = 23'h080101;
+            11'h73b: pattern = 23'h080100;
+            11'h73c: pattern = 23'h008280;
+            11'h73d: pattern = 23'h212000;
+            11'h73e: pattern = 23'h020408;
+            11'h73f: pattern = 23'h080104;
+            11'h740: pattern = 23'h120800;
+            11'h741: pattern = 23'h200088;
+            11'h742: pattern = 23'h00c020;
+            11'h743: pattern = 23'h042040;
+            11'h744: pattern = 23'h082010;
+            11'h745: pattern = 23'h014400;
+            11'h746: pattern = 23'h001280;
+            11'h747: pattern = 23'h420100;
+            11'h748: pattern = 23'h200081;
+            11'h749: pattern = 23'h200080;
+            11'h74a: pattern = 23'h480400;
+            11'h74b: pattern = 23'h200082;
+            11'h74c: pattern = 23'h040120;
+            11'h74d: pattern = 23'h200084;
+            11'h74e: pattern = 23'h110040;
+            11'h74f: pattern = 23'h008810;
+            11'h750: pattern = 23'h082004;
+            11'h751: pattern = 23'h400220;
+            11'h752: pattern = 23'h210100;
+            11'h753: pattern = 23'h101400;
+            11'h754: pattern = 23'h082000;
+            11'h755: pattern = 23'h082001;
+            11'h756: pattern = 23'h082002;
+            11'h757: pattern = 23'h008808;
+            11'h758: pattern = 23'h005040;
+            11'h759: pattern = 23'h200090;
+            11'h75a: pattern = 23'h060200;
+            11'h75b: pattern = 23'h008804;
+            11'h75c: pattern = 23'h082008;
+            11'h75d: pattern = 23'h008802;
+            11'h75e: pattern = 23'h008801;
+            11'h75f: pattern = 23'h008800;
+            11'h760: pattern = 23'h00c002;
+            11'h761: pattern = 23'h400210;
+            11'h762: pattern = 23'h00c000;
+            11'h763: pattern = 23'h00c001;
+            11'h764: pattern = 23'h040108;
+            11'h765: pattern = 23'h001840;
+            11'h766: pattern = 23'h00c004;
+            11'h767: pattern = 23'h380000;
+            11'h768: pattern = 23'h040104;
+            11'h769: pattern = 23'h2000a0;
+            11'h76a: pattern = 23'h00c008;
+            11'h76b: pattern = 23'h031000;
+            11'h76c: pattern = 23'h040100;
+            11'h76d: pattern = 23'h040101;
+            11'h76e: pattern = 23'h040102;
+            11'h76f: pattern = 23'h002600;
+            11'h770: pattern = 23'h400201;
+            11'h771: pattern = 23'h400200;
+            11'h772: pattern = 23'h00c010;
+            11'h773: pattern = 23'h400202;
+            11'h774: pattern = 23'h082020;
+            11'h775: pattern = 23'h400204;
+            11'h776: pattern = 23'h020440;
+            11'h777: pattern = 23'h050080;
+            11'h778: pattern = 23'h010c00;
+            11'h779: pattern = 23'h400208;
+            11'h77a: pattern = 23'h102080;
+            11'h77b: pattern = 23'h080140;
+            11'h77c: pattern = 23'h040110;
+            11'h77d: pattern = 23'h124000;
+            11'h77e: pattern = 23'h601000;
+            11'h77f: pattern = 23'h008820;
+            11'h780: pattern = 23'h090020;
+            11'h781: pattern = 23'h200048;
+            11'h782: pattern = 23'h400810;
+            11'h783: pattern = 23'h042080;
+            11'h784: pattern = 23'h064000;
+            11'h785: pattern = 23'h100110;
+            11'h786: pattern = 23'h001240;
+            11'h787: pattern = 23'h088400;
+            11'h788: pattern = 23'h200041;
+            11'h789: pattern = 23'h200040;
+            11'h78a: pattern = 23'h028100;
+            11'h78b: pattern = 23'h200042;
+            11'h78c: pattern = 23'h002c00;
+            11'h78d: pattern = 23'h200044;
+            11'h78e: pattern = 23'h110080;
+            11'h78f: pattern = 23'h404020;
+            11'h790: pattern = 23'h400802;
+            11'h791: pattern = 23'h100104;
+            11'h792: pattern = 23'h400800;
+            11'h793: pattern = 23'h400801;
+            11'h794: pattern = 23'h100101;
+            11'h795: pattern = 23'h100100;
+            11'h796: pattern = 23'h400804;
+            11'h797: pattern = 23'h100102;
+            11'h798: pattern = 23'h005080;
+            11'h799: pattern = 23'h200050;
+            11'h79a: pattern = 23'h400808;
+            11'h79b: pattern = 23'h010600;
+            11'h79c: pattern = 23'h008220;
+            11'h79d: pattern = 23'h100108;
+            11'h79e: pattern = 23'h2c0000;
+            11'h79f: pattern = 23'h023000;
+            11'h7a0: pattern = 23'h090000;
+            11'h7a1: pattern = 23'h090001;
+            11'h7a2: pattern = 23'h090002;
+            11'h7a3: pattern = 23'h120200;
+            11'h7a4: pattern = 23'h090004;
+            11'h7a5: pattern = 23'h001880;
+            11'h7a6: pattern = 23'h202100;
+            11'h7a7: pattern = 23'h404008;
+            11'h7a8: pattern = 23'h090008;
+            11'h7a9: pattern = 23'h200060;
+            11'h7aa: pattern = 23'h041400;
+            11'h7ab: pattern = 23'h404004;
+            11'h7ac: pattern = 23'h008210;
+            11'h7ad: pattern = 23'h404002;
+            11'h7ae: pattern = 23'h404001;
+            11'h7af: pattern = 23'h404000;
+            11'h7b0: pattern = 23'h090010;
+            11'h7b1: pattern = 23'h006400;
+            11'h7b2: pattern = 23'h400820;
+            11'h7b3: pattern = 23'h209000;
+            11'h7b4: pattern = 23'h008208;
+            11'h7b5: pattern = 23'h100120;
+            11'h7b6: pattern = 23'h020480;
+            11'h7b7: pattern = 23'h050040;
+            11'h7b8: pattern = 23'h008204;
+            11'h7b9: pattern = 23'h060800;
+            11'h7ba: pattern = 23'h102040;
+            11'h7bb: pattern = 23'h080180;
+            11'h7bc: pattern = 23'h008200;
+            11'h7bd: pattern = 23'h008201;
+            11'h7be: pattern = 23'h008202;
+            11'h7bf: pattern = 23'h404010;
+            11'h7c0: pattern = 23'h200009;
+            11'h7c1: pattern = 23'h200008;
+            11'h7c2: pattern = 23'h001204;
+            11'h7c3: pattern = 23'h20000a;
+            11'h7c4: pattern = 23'h001202;
+            11'h7c5: pattern = 23'h20000c;
+            11'h7c6: pattern = 23'h001200;
+            11'h7c7: pattern = 23'h001201;
+            11'h7c8: pattern = 23'h200001;
+            11'h7c9: pattern = 23'h200000;
+            11'h7ca: pattern = 23'h200003;
+            11'h7cb: pattern = 23'h200002;
+            11'h7cc: pattern = 23'h200005;
+            11'h7cd: pattern = 23'h200004;
+            11'h7ce: pattern = 23'h001208;
+            11'h7cf: pattern = 23'h200006;
+            11'h7d0: pattern = 23'h048400;
+            11'h7d1: pattern = 23'h200018;
+            11'h7d2: pattern = 23'h400840;
+            11'h7d3: pattern = 23'h0a4000;
+            11'h7d4: pattern = 23'h082080;
+            11'h7d5: pattern = 23'h100140;
+            11'h7d6: pattern = 23'h001210;
+            11'h7d7: pattern = 23'h050020;
+            11'h7d8: pattern = 23'h200011;
+            11'h7d9: pattern = 23'h200010;
+            11'h7da: pattern = 23'h102020;
+            11'h7db: pattern = 23'h200012;
+            11'h7dc: pattern = 23'h430000;
+            11'h7dd: pattern = 23'h200014;
+            11'h7de: pattern = 23'h004500;
+            11'h7df: pattern = 23'h008880;
+            11'h7e0: pattern = 23'h090040;
+            11'h7e1: pattern = 23'h200028;
+            11'h7e2: pattern = 23'h00c080;
+            11'h7e3: pattern = 23'h000d00;
+            11'h7e4: pattern = 23'h500400;
+            11'h7e5: pattern = 23'h02a000;
+            11'h7e6: pattern = 23'h001220;
+            11'h7e7: pattern = 23'h050010;
+            11'h7e8: pattern = 23'h200021;
+            11'h7e9: pattern = 23'h200020;
+            11'h7ea: pattern = 23'h102010;
+            11'h7eb: pattern = 23'h200022;
+            11'h7ec: pattern = 23'h040180;
+            11'h7ed: pattern = 23'h200024;
+            11'h7ee: pattern = 23'h0a0800;
+            11'h7ef: pattern = 23'h404040;
+            11'h7f0: pattern = 23'h021100;
+            11'h7f1: pattern = 23'h400280;
+            11'h7f2: pattern = 23'h102008;
+            11'h7f3: pattern = 23'h050004;
+            11'h7f4: pattern = 23'h204800;
+            11'h7f5: pattern = 23'h050002;
+            11'h7f6: pattern = 23'h050001;
+            11'h7f7: pattern = 23'h050000;
+            11'h7f8: pattern = 23'h102002;
+            11'h7f9: pattern = 23'h200030;
+            11'h7fa: pattern = 23'h102000;
+            11'h7fb: pattern = 23'h102001;
+            11'h7fc: pattern = 23'h008240;
+            11'h7fd: pattern = 23'h081400;
+            11'h7fe: pattern = 23'h102004;
+            11'h7ff: pattern = 23'h050008;
+            default: pattern = 23'h000000;
+        endcase
+    end
+
+    assign fixed = codeword ^ pattern;
+    assign data = fixed[22:11];
+    assign corrected = |syndrome;
+endmodule
@@ -0,0 +1,144 @@
+package golay
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Codeword is a 23-bit Golay(23,12) codeword laid out as [data(12-bit) | parity(11-bit)],
+// the same layout returned by EncodeWord and accepted by Decode.
+// Bits above the 23rd are ignored by all methods.
+type Codeword uint32
+
+// NewCodeword encodes 12-bit data into a Codeword.
+// Input values exceeding 12 bits are masked to 12 bits.
+func NewCodeword(data uint16) Codeword {
+	return Codeword(EncodeWord(data))
+}
+
+// Data returns the 12-bit data portion without error correction.
+func (c Codeword) Data() uint16 {
+	return uint16(c>>11) & 0xFFF
+}
+
+// Parity returns the 11-bit parity portion.
+func (c Codeword) Parity() uint16 {
+	return uint16(c) & 0x7FF
+}
+
+// Syndrome returns the 11-bit syndrome. It is zero if and only if c is a valid codeword.
+func (c Codeword) Syndrome() uint16 {
+	return syndromeOf(uint32(c) & 0x7FFFFF)
+}
+
+// Valid reports whether c is a valid codeword.
+func (c Codeword) Valid() bool {
+	return c.Syndrome() == 0
+}
+
+// Weight returns the Hamming weight (number of set bits).
+func (c Codeword) Weight() int {
+	return bits.OnesCount32(uint32(c) & 0x7FFFFF)
+}
+
+// Distance returns the Hamming distance between c and o.
+func (c Codeword) Distance(o Codeword) int {
+	return (c ^ o).Weight()
+}
+
+// Decode corrects up to 3-bit errors and returns the recovered 12-bit data.
+func (c Codeword) Decode() uint16 {
+	return Decode(uint32(c))
+}
+
+// Correct returns the valid codeword closest to c.
+func (c Codeword) Correct() Codeword {
+	return NewCodeword(c.Decode())
+}
+
+// Rotate returns c cyclically rotated left (towards the MSB) by n bits within 23 bits.
+// Negative n rotates right. Since Golay(23,12) is a cyclic code,
+// rotating a valid codeword yields another valid codeword.
+func (c Codeword) Rotate(n int) Codeword {
+	n %= 23
+	if n < 0 {
+		n += 23
+	}
+	v := uint32(c) & 0x7FFFFF
+	return Codeword((v<<n | v>>(23-n)) & 0x7FFFFF)
+}
+
+// String returns the codeword as binary digits with the data and parity split by '|',
+// for example "101100110011|11011011101".
+func (c Codeword) String() string {
+	return fmt.Sprintf("%012b|%011b", c.Data(), c.Parity())
+}
+
+// Format implements fmt.Formatter.
+// The %v and %s verbs print String(); other verbs format the codeword as a uint32,
+// so %b, %x and %d behave as for plain integers.
+func (c Codeword) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		fmt.Fprint(f, c.String())
+	case 'q':
+		fmt.Fprintf(f, "%q", c.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), uint32(c))
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The codeword is encoded as 3 bytes in big-endian order.
+func (c Codeword) MarshalBinary() ([]byte, error) {
+	v := uint32(c) & 0x7FFFFF
+	return []byte{byte(v >> 16), byte(v >> 8), byte(v)}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// data must be 3 bytes in big-endian order holding a 23-bit value.
+func (c *Codeword) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 {
+		return errors.New("golay: codeword must be 3 bytes")
+	}
+	if data[0]&0x80 != 0 {
+		return errors.New("golay: codeword exceeds 23 bits")
+	}
+	*c = Codeword(uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2]))
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the String representation.
+func (c Codeword) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the String representation, or 23 binary digits without a separator.
+func (c *Codeword) UnmarshalText(text []byte) error {
+	s := string(text)
+	if data, parity, ok := strings.Cut(s, "|"); ok {
+		if len(data) != 12 || len(parity) != 11 {
+			return fmt.Errorf("golay: invalid codeword %q: want 12 data and 11 parity digits", s)
+		}
+		s = data + parity
+	}
+	if len(s) != 23 {
+		return fmt.Errorf("golay: invalid codeword %q: want 23 binary digits", s)
+	}
+	var v uint32
+	for _, r := range s {
+		switch r {
+		case '0':
+			v <<= 1
+		case '1':
+			v = v<<1 | 1
+		default:
+			return fmt.Errorf("golay: invalid codeword %q: unexpected character %q", s, r)
+		}
+	}
+	*c = Codeword(v)
+	return nil
+}
@@ -0,0 +1,120 @@
+package golay
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Codeword(0)
+	_ encoding.BinaryUnmarshaler = (*Codeword)(nil)
+	_ encoding.TextMarshaler     = Codeword(0)
+	_ encoding.TextUnmarshaler   = (*Codeword)(nil)
+	_ fmt.Formatter              = Codeword(0)
+)
+
+func TestCodeword(t *testing.T) {
+	t.Run("Fields", func(t *testing.T) {
+		var max uint16 = 1<<12 - 1
+		for d := range max {
+			c := NewCodeword(d)
+			if uint32(c) != EncodeWord(d) {
+				t.Fatalf("NewCodeword failed for data %d: got %#x, want %#x", d, c, EncodeWord(d))
+			}
+			if c.Data() != d {
+				t.Fatalf("Codeword.Data() failed for data %d: got %d", d, c.Data())
+			}
+			if c.Parity() != Encode(d) {
+				t.Fatalf("Codeword.Parity() failed for data %d: got %d, want %d", d, c.Parity(), Encode(d))
+			}
+			if !c.Valid() {
+				t.Fatalf("Codeword.Valid() failed for data %d", d)
+			}
+			for n := -23; n <= 23; n++ {
+				if r := c.Rotate(n); !r.Valid() || r.Weight() != c.Weight() {
+					t.Fatalf("Codeword.Rotate(%d) failed for data %d: got %v", n, d, r)
+				}
+			}
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		c := NewCodeword(0xABC)
+		e := c ^ 0b101
+		if e.Valid() {
+			t.Errorf("Codeword.Valid() failed: corrupted codeword reported valid")
+		}
+		if e.Syndrome() == 0 {
+			t.Errorf("Codeword.Syndrome() failed: got 0")
+		}
+		if e.Distance(c) != 2 {
+			t.Errorf("Codeword.Distance() failed: got %d, want %d", e.Distance(c), 2)
+		}
+		if e.Decode() != 0xABC {
+			t.Errorf("Codeword.Decode() failed: got %#x, want %#x", e.Decode(), 0xABC)
+		}
+		if e.Correct() != c {
+			t.Errorf("Codeword.Correct() failed: got %v, want %v", e.Correct(), c)
+		}
+	})
+	t.Run("Rotate", func(t *testing.T) {
+		c := Codeword(1 << 22)
+		if c.Rotate(1) != 1 {
+			t.Errorf("Codeword.Rotate(1) failed: got %#x, want %#x", uint32(c.Rotate(1)), 1)
+		}
+		if c.Rotate(-1) != 1<<21 {
+			t.Errorf("Codeword.Rotate(-1) failed: got %#x, want %#x", uint32(c.Rotate(-1)), 1<<21)
+		}
+	})
+	t.Run("Format", func(t *testing.T) {
+		c := Codeword(0b101100110011<<11 | 0b10101011100)
+		for _, tt := range []struct {
+			format string
+			want   string
+		}{
+			{"%v", "101100110011|10101011100"},
+			{"%s", "101100110011|10101011100"},
+			{"%q", `"101100110011|10101011100"`},
+			{"%x", "599d5c"},
+			{"%#08x", "0x00599d5c"},
+			{"%d", "5872988"},
+			{"%b", "10110011001110101011100"},
+		} {
+			if got := fmt.Sprintf(tt.format, c); got != tt.want {
+				t.Errorf("Sprintf(%q) failed: got %q, want %q", tt.format, got, tt.want)
+			}
+		}
+	})
+	t.Run("Marshal", func(t *testing.T) {
+		c := NewCodeword(0x5A5)
+		b, _ := c.MarshalBinary()
+		if len(b) != 3 {
+			t.Fatalf("Codeword.MarshalBinary() failed: got %d bytes, want %d", len(b), 3)
+		}
+		var got Codeword
+		if err := got.UnmarshalBinary(b); err != nil || got != c {
+			t.Errorf("Codeword.UnmarshalBinary() failed: got %v, %v, want %v", got, err, c)
+		}
+		if err := got.UnmarshalBinary([]byte{0x80, 0, 0}); err == nil {
+			t.Errorf("Codeword.UnmarshalBinary() should reject values exceeding 23 bits")
+		}
+		if err := got.UnmarshalBinary([]byte{0, 0}); err == nil {
+			t.Errorf("Codeword.UnmarshalBinary() should reject short input")
+		}
+
+		text, _ := c.MarshalText()
+		got = 0
+		if err := got.UnmarshalText(text); err != nil || got != c {
+			t.Errorf("Codeword.UnmarshalText(%q) failed: got %v, %v, want %v", text, got, err, c)
+		}
+		got = 0
+		if err := got.UnmarshalText([]byte(fmt.Sprintf("%023b", uint32(c)))); err != nil || got != c {
+			t.Errorf("Codeword.UnmarshalText() without separator failed: got %v, %v, want %v", got, err, c)
+		}
+		for _, s := range []string{"", "1011|0011", "10110011001x|10101011100", "101100110011101010111001"} {
+			if err := got.UnmarshalText([]byte(s)); err == nil {
+				t.Errorf("Codeword.UnmarshalText(%q) should fail", s)
+			}
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package golay
+
+import "fmt"
+
+// CombineHard combines hard-decision copies of the same 23-bit codeword by bitwise majority.
+// Input values exceeding 23 bits are masked to 23 bits.
+// A bit with as many ones as zeros, which can only occur with an even number of copies,
+// takes its value from the first copy. It returns ErrNoCopies if no copies are given.
+func CombineHard(copies ...uint32) (uint32, error) {
+	if len(copies) == 0 {
+		return 0, ErrNoCopies
+	}
+	var combined uint32
+	for i := range 23 {
+		bit := uint32(1) << i
+		ones := 0
+		for _, c := range copies {
+			if c&bit != 0 {
+				ones++
+			}
+		}
+		if 2*ones > len(copies) || 2*ones == len(copies) && copies[0]&bit != 0 {
+			combined |= bit
+		}
+	}
+	return combined, nil
+}
+
+// DecodeMajority combines hard-decision copies of the same codeword with CombineHard
+// and decodes the result with Decode.
+func DecodeMajority(copies ...uint32) (uint16, error) {
+	c, err := CombineHard(copies...)
+	if err != nil {
+		return 0, err
+	}
+	return Decode(c), nil
+}
+
+// CombineSoft combines soft-decision copies of the same bits by adding their
+// log-likelihood ratios, which is optimal for independent noise (maximum ratio combining).
+// The copies may have any length, such as 23 values for one codeword or the LLRs
+// of a whole stream, but all copies must have the same length.
+// It returns ErrNoCopies if no copies are given and ErrLengthMismatch if their lengths differ.
+func CombineSoft(copies ...[]float64) ([]float64, error) {
+	if len(copies) == 0 {
+		return nil, ErrNoCopies
+	}
+	combined := make([]float64, len(copies[0]))
+	for _, c := range copies {
+		if len(c) != len(combined) {
+			return nil, ErrLengthMismatch
+		}
+		for i, l := range c {
+			combined[i] += l
+		}
+	}
+	return combined, nil
+}
+
+// DecodeSoftCombined combines soft-decision copies of the same codeword with CombineSoft
+// and decodes the result with DecodeSoft. Each copy must hold 23 values; otherwise
+// an error wrapping ErrLengthMismatch is returned.
+func DecodeSoftCombined(copies ...[]float64) (uint16, error) {
+	llr, err := CombineSoft(copies...)
+	if err != nil {
+		return 0, err
+	}
+	if len(llr) != 23 {
+		return 0, fmt.Errorf("%w: got %d values, want 23", ErrLengthMismatch, len(llr))
+	}
+	return DecodeSoft(llr), nil
+}
+
+// CombineCaptures combines several captures of the same encoded stream, such as the output
+// of one Encoder received through repeated transmissions or by multiple receivers,
+// by bitwise majority. The result can be passed to NewDecoder.
+// A bit with as many ones as zeros takes its value from the first capture.
+// It returns ErrNoCopies if no captures are given and ErrLengthMismatch if their lengths differ.
+func CombineCaptures[T BinaryValue](captures ...[]T) ([]T, error) {
+	if len(captures) == 0 {
+		return nil, ErrNoCopies
+	}
+	n := len(captures[0])
+	for _, c := range captures[1:] {
+		if len(c) != n {
+			return nil, ErrLengthMismatch
+		}
+	}
+	size := bitSize[T]()
+	combined := make([]T, n)
+	for i := range combined {
+		for b := range size {
+			bit := T(1) << b
+			ones := 0
+			for _, c := range captures {
+				if c[i]&bit != 0 {
+					ones++
+				}
+			}
+			if 2*ones > len(captures) || 2*ones == len(captures) && captures[0][i]&bit != 0 {
+				combined[i] |= bit
+			}
+		}
+	}
+	return combined, nil
+}
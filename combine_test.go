@@ -0,0 +1,110 @@
+package golay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	t.Run("CombineHard", func(t *testing.T) {
+		c := EncodeWord(0xABC)
+		// 3 copies with 4 errors each at different positions
+		copies := []uint32{c ^ 0x00000F, c ^ 0x0000F0, c ^ 0x000F00}
+		for _, cp := range copies {
+			if Decode(cp) == 0xABC {
+				t.Fatalf("Decode(%06x) unexpectedly corrected 4 errors", cp)
+			}
+		}
+		if got, err := CombineHard(copies...); err != nil || got != c {
+			t.Errorf("CombineHard failed: got %06x, %v, want %06x", got, err, c)
+		}
+		if got, err := DecodeMajority(copies...); err != nil || got != 0xABC {
+			t.Errorf("DecodeMajority failed: got %03x, %v, want %03x", got, err, 0xABC)
+		}
+		// ties take the first copy
+		if got, _ := CombineHard(0x0F, 0xF0); got != 0x0F {
+			t.Errorf("CombineHard tie failed: got %06x, want %06x", got, 0x0F)
+		}
+		if got, _ := CombineHard(0xFF800000 | c); got != c {
+			t.Errorf("CombineHard mask failed: got %06x, want %06x", got, c)
+		}
+		if _, err := CombineHard(); !errors.Is(err, ErrNoCopies) {
+			t.Errorf("CombineHard() failed: got %v, want %v", err, ErrNoCopies)
+		}
+		if _, err := DecodeMajority(); !errors.Is(err, ErrNoCopies) {
+			t.Errorf("DecodeMajority() failed: got %v, want %v", err, ErrNoCopies)
+		}
+	})
+	t.Run("CombineSoft", func(t *testing.T) {
+		c := EncodeWord(0x5A5)
+		// two noisy copies that each have 4 confident errors
+		copies := make([][]float64, 2)
+		for k := range copies {
+			copies[k] = make([]float64, 23)
+			for i := range 23 {
+				l := 1.0
+				if c&(1<<(22-i)) != 0 {
+					l = -1
+				}
+				copies[k][i] = l
+			}
+		}
+		for _, i := range []int{0, 3, 7, 11} {
+			copies[0][i] = -copies[0][i] * 0.5
+		}
+		for _, i := range []int{13, 16, 19, 22} {
+			copies[1][i] = -copies[1][i] * 0.5
+		}
+		combined, err := CombineSoft(copies...)
+		if err != nil || combined[0] != 0.5 && combined[0] != -0.5 {
+			t.Errorf("CombineSoft failed: got %v, %v, want ±0.5", combined, err)
+		}
+		if got, err := DecodeSoftCombined(copies...); err != nil || got != 0x5A5 {
+			t.Errorf("DecodeSoftCombined failed: got %03x, %v, want %03x", got, err, 0x5A5)
+		}
+		for _, tt := range []struct {
+			name   string
+			copies [][]float64
+			want   error
+		}{
+			{"no copies", nil, ErrNoCopies},
+			{"different lengths", [][]float64{copies[0], copies[1][1:]}, ErrLengthMismatch},
+			{"not 23 values", [][]float64{copies[0][1:], copies[1][1:]}, ErrLengthMismatch},
+		} {
+			if _, err := DecodeSoftCombined(tt.copies...); !errors.Is(err, tt.want) {
+				t.Errorf("DecodeSoftCombined with %s failed: got %v, want %v", tt.name, err, tt.want)
+			}
+		}
+	})
+	t.Run("CombineCaptures", func(t *testing.T) {
+		data := []uint8{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}
+		var encoded []uint16
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode(data, 0)
+		// each capture has a burst that is uncorrectable on its own
+		captures := make([][]uint16, 3)
+		for k := range captures {
+			captures[k] = slices.Clone(encoded)
+			captures[k][k] ^= 0x0FF0
+		}
+		combined, err := CombineCaptures(captures...)
+		if err != nil {
+			t.Fatalf("CombineCaptures failed: %v", err)
+		}
+		if !slices.Equal(combined, encoded) {
+			t.Errorf("CombineCaptures failed: got %#x, want %#x", combined, encoded)
+		}
+		var decoded []uint8
+		_ = NewDecoder(combined, enc.Bits()).Decode(&decoded)
+		if !slices.Equal(decoded[:len(data)], data) {
+			t.Errorf("Decoder failed: got %#x, want %#x", decoded, data)
+		}
+		if _, err := CombineCaptures[uint16](); !errors.Is(err, ErrNoCopies) {
+			t.Errorf("CombineCaptures() failed: got %v, want %v", err, ErrNoCopies)
+		}
+		if _, err := CombineCaptures(encoded, encoded[1:]); !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("CombineCaptures with different lengths failed: got %v, want %v", err, ErrLengthMismatch)
+		}
+	})
+}
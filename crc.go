@@ -0,0 +1,112 @@
+package golay
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// CRC describes a cyclic redundancy check in the parameter model of the
+// CRC catalogue by Greg Cook (https://reveng.sourceforge.io/crc-catalogue/).
+// The zero value has Width 0 and disables CRC framing in Encoder.SetCRC and Decoder.SetCRC.
+type CRC struct {
+	// Name is the catalogue name of the algorithm.
+	Name string
+	// Width is the number of bits of the check value: 8, 16 or 32.
+	Width int
+	// Poly is the generator polynomial without the leading term, MSB first.
+	Poly uint32
+	// Init is the initial register value.
+	Init uint32
+	// RefIn reflects each input byte before processing.
+	RefIn bool
+	// RefOut reflects the final register value before XorOut is applied.
+	RefOut bool
+	// XorOut is XORed into the final value.
+	XorOut uint32
+	// Check is the check value of the ASCII string "123456789".
+	Check uint32
+}
+
+// The CRC catalogue.
+var (
+	// CRC8 is CRC-8/SMBUS.
+	CRC8 = CRC{Name: "CRC-8/SMBUS", Width: 8, Poly: 0x07, Check: 0xF4}
+	// CRC8AUTOSAR is CRC-8/AUTOSAR.
+	CRC8AUTOSAR = CRC{Name: "CRC-8/AUTOSAR", Width: 8, Poly: 0x2F, Init: 0xFF, XorOut: 0xFF, Check: 0xDF}
+	// CRC16CCITT is CRC-16/IBM-3740, also known as CRC-16/CCITT-FALSE.
+	CRC16CCITT = CRC{Name: "CRC-16/IBM-3740", Width: 16, Poly: 0x1021, Init: 0xFFFF, Check: 0x29B1}
+	// CRC16XMODEM is CRC-16/XMODEM.
+	CRC16XMODEM = CRC{Name: "CRC-16/XMODEM", Width: 16, Poly: 0x1021, Check: 0x31C3}
+	// CRC16ARC is CRC-16/ARC.
+	CRC16ARC = CRC{Name: "CRC-16/ARC", Width: 16, Poly: 0x8005, RefIn: true, RefOut: true, Check: 0xBB3D}
+	// CRC32 is CRC-32/ISO-HDLC, the CRC of Ethernet, zlib and hash/crc32.IEEE.
+	CRC32 = CRC{Name: "CRC-32/ISO-HDLC", Width: 32, Poly: 0x04C11DB7, Init: 0xFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFF, Check: 0xCBF43926}
+	// CRC32C is CRC-32/ISCSI (Castagnoli).
+	CRC32C = CRC{Name: "CRC-32/ISCSI", Width: 32, Poly: 0x1EDC6F41, Init: 0xFFFFFFFF, RefIn: true, RefOut: true, XorOut: 0xFFFFFFFF, Check: 0xE3069283}
+)
+
+// CRCs lists the CRC catalogue.
+var CRCs = []CRC{CRC8, CRC8AUTOSAR, CRC16CCITT, CRC16XMODEM, CRC16ARC, CRC32, CRC32C}
+
+// Checksum returns the CRC of data. The zero CRC returns 0.
+func (c CRC) Checksum(data []byte) uint32 {
+	if c.Width == 0 {
+		return 0
+	}
+	top := uint32(1) << (c.Width - 1)
+	mask := top<<1 - 1
+	reg := c.Init & mask
+	for _, b := range data {
+		if c.RefIn {
+			b = bits.Reverse8(b)
+		}
+		reg ^= uint32(b) << (c.Width - 8)
+		for range 8 {
+			if reg&top != 0 {
+				reg = reg<<1 ^ c.Poly
+			} else {
+				reg <<= 1
+			}
+			reg &= mask
+		}
+	}
+	if c.RefOut {
+		reg = bits.Reverse32(reg) >> (32 - c.Width)
+	}
+	return (reg ^ c.XorOut) & mask
+}
+
+// validate returns an error if c is not a usable CRC. The zero value is valid.
+func (c CRC) validate() error {
+	if c.Width != 0 && c.Width != 8 && c.Width != 16 && c.Width != 32 {
+		return fmt.Errorf("golay: unsupported CRC width %d (want 8, 16 or 32)", c.Width)
+	}
+	return nil
+}
+
+// IntegrityError is returned by Decoder.Decode when the CRC of the decoded frame does not match,
+// which means at least one block had more errors than could be corrected.
+type IntegrityError struct {
+	// CRC is the name of the CRC algorithm.
+	CRC string
+	// Received is the check value carried by the frame.
+	Received uint32
+	// Computed is the check value computed over the decoded data.
+	Computed uint32
+}
+
+// Error implements the error interface.
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("golay: %s mismatch: received %#x, computed %#x", e.CRC, e.Received, e.Computed)
+}
+
+// frameChecksum computes the CRC over the first n bits of the MSB-aligned frame.
+// The bits are packed into bytes and the last byte is zero-padded.
+func frameChecksum(c CRC, frame []uint8, n int) uint32 {
+	buf := make([]uint8, (n+7)/8)
+	copy(buf, frame)
+	if n%8 != 0 {
+		buf[len(buf)-1] &= 0xFF << (8 - n%8)
+	}
+	return c.Checksum(buf)
+}
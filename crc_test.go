@@ -0,0 +1,81 @@
+package golay
+
+import (
+	"errors"
+	"hash/crc32"
+	"slices"
+	"testing"
+)
+
+func TestCRC(t *testing.T) {
+	t.Run("Catalogue", func(t *testing.T) {
+		for _, c := range CRCs {
+			if got := c.Checksum([]byte("123456789")); got != c.Check {
+				t.Errorf("%s: Checksum failed: got %#x, want %#x", c.Name, got, c.Check)
+			}
+		}
+		data := []byte("Golay(23,12)")
+		if got, want := CRC32.Checksum(data), crc32.ChecksumIEEE(data); got != want {
+			t.Errorf("CRC32.Checksum failed: got %#x, want %#x", got, want)
+		}
+		if got, want := CRC32C.Checksum(data), crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)); got != want {
+			t.Errorf("CRC32C.Checksum failed: got %#x, want %#x", got, want)
+		}
+	})
+	t.Run("Frame", func(t *testing.T) {
+		data := []uint8{0xDE, 0xAD, 0xBE, 0xEF, 0x01}
+		for _, c := range CRCs {
+			for _, bits := range []int{1, 12, 13, 20, 40} {
+				var encoded []uint16
+				enc := NewEncoder(&encoded)
+				enc.SetCRC(c)
+				_ = enc.Encode(data, bits)
+				if want := (bits + c.Width + 11) / 12 * 23; enc.Bits() != want {
+					t.Fatalf("%s/%d: Encoder.Bits() failed: got %d, want %d", c.Name, bits, enc.Bits(), want)
+				}
+
+				var decoded []uint8
+				dec := NewDecoder(encoded, enc.Bits())
+				dec.SetCRC(c)
+				if err := dec.Decode(&decoded); err != nil {
+					t.Fatalf("%s/%d: Decoder.Decode failed: %v", c.Name, bits, err)
+				}
+				if want := enc.Bits()/23*12 - c.Width; dec.Bits() != want {
+					t.Fatalf("%s/%d: Decoder.Bits() failed: got %d, want %d", c.Name, bits, dec.Bits(), want)
+				}
+				if got, want := getBits(decoded, 0, min(bits, 32)), getBits(data, 0, min(bits, 32)); got != want {
+					t.Errorf("%s/%d: Decoder.Decode failed: got %#x, want %#x", c.Name, bits, got, want)
+				}
+				if len(decoded) != (dec.Bits()+7)/8 {
+					t.Errorf("%s/%d: Decoder.Decode failed: got %d bytes, want %d", c.Name, bits, len(decoded), (dec.Bits()+7)/8)
+				}
+
+				// 4 errors in the first block are miscorrected and caught by the CRC
+				corrupted := slices.Clone(encoded)
+				corrupted[0] ^= 0xF000
+				dec = NewDecoder(corrupted, enc.Bits())
+				dec.SetCRC(c)
+				var ie *IntegrityError
+				if err := dec.Decode(&decoded); !errors.As(err, &ie) || ie.CRC != c.Name || ie.Received == ie.Computed {
+					t.Errorf("%s/%d: Decoder.Decode failed: got error %v, want *IntegrityError", c.Name, bits, err)
+				}
+			}
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		var encoded []uint8
+		_ = NewEncoder(&encoded).Encode([]uint8{0xAB}, 8)
+		dec := NewDecoder(encoded, 23)
+		dec.SetCRC(CRC16CCITT)
+		var out []uint8
+		if err := dec.Decode(&out); err == nil {
+			t.Error("Decoder.Decode failed: got nil error for input shorter than the CRC")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("SetCRC failed: want panic for unsupported width")
+			}
+		}()
+		dec.SetCRC(CRC{Width: 12})
+	})
+}
@@ -0,0 +1,81 @@
+package golay
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned for invalid arguments to the stream API.
+var (
+	// ErrNilData is returned when the input data is nil.
+	ErrNilData = errors.New("golay: data must not be nil")
+	// ErrNotSlice is returned when the input data is not a slice.
+	ErrNotSlice = errors.New("golay: data must be a slice")
+	// ErrNilOutput is returned when the output is nil.
+	ErrNilOutput = errors.New("golay: v must not be nil")
+	// ErrNotSlicePointer is returned when the output is not a pointer to a slice.
+	ErrNotSlicePointer = errors.New("golay: v must be a pointer to a slice")
+	// ErrElemType is returned when a slice element type does not satisfy BinaryValue.
+	ErrElemType = errors.New("golay: slice element type must satisfy BinaryValue constraint")
+	// ErrShortData is returned when the input is too short for the configured framing.
+	ErrShortData = errors.New("golay: data is shorter than the CRC")
+	// ErrBitsExceedData is returned in StrictLength mode when the bits argument is larger than the data.
+	ErrBitsExceedData = errors.New("golay: bits exceeds the length of data")
+	// ErrTruncated is returned in StrictLength mode when the input is not a whole number of blocks.
+	ErrTruncated = errors.New("golay: data is not a whole number of blocks")
+	// ErrBitsRequired is returned in StrictLength mode when bits is 0 and the data elements are wider than 8 bits.
+	ErrBitsRequired = errors.New("golay: bits must be set for elements wider than 8 bits")
+)
+
+// Errors returned for invalid arguments to the combining functions.
+var (
+	// ErrNoCopies is returned when no copies or captures are given.
+	ErrNoCopies = errors.New("golay: copies must not be empty")
+	// ErrLengthMismatch is returned when copies or captures differ in length.
+	ErrLengthMismatch = errors.New("golay: copies must have the same length")
+)
+
+// ErrRejected is the cause of a DecodeError for blocks that need more corrections
+// than the decoding policy allows.
+var ErrRejected = errors.New("golay: block rejected by decoding policy")
+
+// DecodeError reports blocks that could not be decoded reliably.
+// It is returned by Decoder.Decode and ChunkDecoder.Decode after the output has been written,
+// so the decoded data is still available. Use errors.As to obtain it and
+// errors.Is or errors.As on its cause:
+//
+//   - ErrRejected if blocks were rejected by the decoding policy or, with Decoder.SetExtended,
+//     detected as uncorrectable by the overall parity bit.
+//   - *IntegrityError if the CRC of the frame did not match.
+type DecodeError struct {
+	// Blocks lists the indices of the failing blocks in ascending order.
+	// It is empty for a CRC mismatch without rejected blocks, which cannot be attributed to a block.
+	// The data of block i starts at bit i*12 of the decoded output.
+	Blocks []int
+	// Offsets lists the bit offset of each failing block within the encoded input.
+	Offsets []int
+	// Err is the cause of the failure.
+	Err error
+}
+
+// newDecodeError returns a DecodeError for the given blocks of a stream of n-bit codewords.
+func newDecodeError(blocks []int, n int, err error) *DecodeError {
+	e := &DecodeError{Blocks: blocks, Err: err}
+	for _, b := range blocks {
+		e.Offsets = append(e.Offsets, b*n)
+	}
+	return e
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	if len(e.Blocks) == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: %d block(s), first block %d at bit %d", e.Err, len(e.Blocks), e.Blocks[0], e.Offsets[0])
+}
+
+// Unwrap returns the cause of the failure.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
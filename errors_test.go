@@ -0,0 +1,145 @@
+package golay
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("Sentinels", func(t *testing.T) {
+		var out []uint8
+		enc := NewEncoder(&out)
+		tests := []struct {
+			name string
+			err  error
+			want error
+		}{
+			{"Encode(nil)", enc.Encode(nil, 0), ErrNilData},
+			{"Encode(int)", enc.Encode(1, 0), ErrNotSlice},
+			{"Encode([]int8)", enc.Encode([]int8{1}, 0), ErrElemType},
+			{"Decode(nil)", NewDecoder([]uint8{1}, 0).Decode(nil), ErrNilOutput},
+			{"Decode([]uint8)", NewDecoder([]uint8{1}, 0).Decode([]uint8{}), ErrNotSlicePointer},
+			{"Decode(*[]int)", NewDecoder([]uint8{1}, 0).Decode(&[]int{}), ErrElemType},
+			{"ChunkDecoder.Decode(nil)", NewChunkDecoder(&out).Decode(nil, 0), ErrNilData},
+			{"ChunkDecoder.Decode(string)", NewChunkDecoder(&out).Decode("x", 0), ErrNotSlice},
+		}
+		for _, tt := range tests {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("%s failed: got %v, want %v", tt.name, tt.err, tt.want)
+			}
+		}
+		dec := NewDecoder([]uint8{1, 2}, 16)
+		dec.SetCRC(CRC8)
+		if err := dec.Decode(&out); !errors.Is(err, ErrShortData) {
+			t.Errorf("Decode with CRC failed: got %v, want %v", err, ErrShortData)
+		}
+	})
+	t.Run("Prefix", func(t *testing.T) {
+		for _, err := range []error{
+			ErrNilData, ErrNotSlice, ErrNilOutput, ErrNotSlicePointer, ErrElemType, ErrShortData,
+			ErrBitsExceedData, ErrTruncated, ErrBitsRequired, ErrRejected, ErrCheckFailed, ErrNoLock, ErrUnrecognized,
+			ErrNoCopies, ErrLengthMismatch,
+			CRC{Width: 12}.validate(),
+		} {
+			if !strings.HasPrefix(err.Error(), "golay: ") {
+				t.Errorf("error %q failed: want prefix %q", err, "golay: ")
+			}
+		}
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		var encoded []uint8
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode([]uint8{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, 0)
+		// 1 error in blocks 1 and 3
+		for _, p := range []int{23 + 4, 69 + 10} {
+			encoded[p/8] ^= 0x80 >> (p % 8)
+		}
+		var out []uint8
+		dec := NewDecoder(encoded, enc.Bits())
+		if err := dec.Decode(&out); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		dec.SetPolicy(DetectOnly)
+		err := dec.Decode(&out)
+		var de *DecodeError
+		if !errors.As(err, &de) || !errors.Is(err, ErrRejected) {
+			t.Fatalf("Decode failed: got %v, want *DecodeError caused by ErrRejected", err)
+		}
+		if !slices.Equal(de.Blocks, []int{1, 3}) || !slices.Equal(de.Offsets, []int{23, 69}) {
+			t.Errorf("DecodeError failed: got blocks %v at %v, want [1 3] at [23 69]", de.Blocks, de.Offsets)
+		}
+		if want := "golay: block rejected by decoding policy: 2 block(s), first block 1 at bit 23"; err.Error() != want {
+			t.Errorf("DecodeError.Error() failed: got %q, want %q", err.Error(), want)
+		}
+
+		var chunked []uint8
+		chunks := NewChunkDecoder(&chunked)
+		chunks.SetPolicy(DetectOnly)
+		if err := chunks.Decode(encoded[:6], 0); !errors.As(err, &de) || !slices.Equal(de.Blocks, []int{1}) {
+			t.Errorf("ChunkDecoder.Decode failed: got %v, want *DecodeError for block 1", err)
+		}
+		if err := chunks.Decode(encoded[6:], enc.Bits()-48); !errors.As(err, &de) || !slices.Equal(de.Blocks, []int{3}) {
+			t.Errorf("ChunkDecoder.Decode failed: got %v, want *DecodeError for block 3", err)
+		}
+	})
+	t.Run("Extended", func(t *testing.T) {
+		var encoded []uint8
+		enc := NewEncoder(&encoded)
+		enc.SetExtended(true)
+		in := []uint8{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}
+		_ = enc.Encode(in, 0)
+		if enc.Bits() != 4*24 {
+			t.Fatalf("Encoder.Bits failed: got %d, want %d", enc.Bits(), 4*24)
+		}
+		// 3 errors in block 0 are corrected, 4 errors in block 2 are detected
+		for _, p := range []int{1, 5, 9, 48 + 2, 48 + 7, 48 + 13, 48 + 20} {
+			encoded[p/8] ^= 0x80 >> (p % 8)
+		}
+		var out []uint8
+		dec := NewDecoder(encoded, enc.Bits())
+		dec.SetExtended(true)
+		err := dec.Decode(&out)
+		var de *DecodeError
+		if !errors.As(err, &de) || !errors.Is(err, ErrRejected) {
+			t.Fatalf("Decode failed: got %v, want *DecodeError caused by ErrRejected", err)
+		}
+		if !slices.Equal(de.Blocks, []int{2}) || !slices.Equal(de.Offsets, []int{48}) {
+			t.Errorf("DecodeError failed: got blocks %v at %v, want [2] at [48]", de.Blocks, de.Offsets)
+		}
+		if !slices.Equal(dec.Rejected(), []int{2}) {
+			t.Errorf("Rejected failed: got %v, want [2]", dec.Rejected())
+		}
+		if !slices.Equal(out[:3], in[:3]) || !slices.Equal(out[4:], in[4:]) {
+			t.Errorf("Decode failed: got %x, want %x outside block 2", out, in)
+		}
+		if got := dec.Bits(); got != 48 {
+			t.Errorf("Bits failed: got %d, want 48", got)
+		}
+
+		dec.SetExtended(false)
+		if err := dec.Decode(&out); errors.Is(err, ErrRejected) {
+			t.Errorf("Decode without SetExtended failed: got %v, want no rejected blocks", err)
+		}
+	})
+	t.Run("Integrity", func(t *testing.T) {
+		var encoded []uint8
+		enc := NewEncoder(&encoded)
+		enc.SetCRC(CRC16CCITT)
+		_ = enc.Encode([]uint8{0x12, 0x34, 0x56}, 0)
+		encoded[0] ^= 0xF0
+		var out []uint8
+		dec := NewDecoder(encoded, enc.Bits())
+		dec.SetCRC(CRC16CCITT)
+		err := dec.Decode(&out)
+		var de *DecodeError
+		var ie *IntegrityError
+		if !errors.As(err, &de) || !errors.As(err, &ie) || len(de.Blocks) != 0 {
+			t.Errorf("Decode failed: got %v, want *DecodeError without blocks wrapping *IntegrityError", err)
+		}
+		if err != nil && err.Error() != ie.Error() {
+			t.Errorf("DecodeError.Error() failed: got %q, want %q", err.Error(), ie.Error())
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package golay
+
+import "math/bits"
+
+// EncodeExtended encodes 12-bit data into a 24-bit extended Golay(24,12) codeword.
+// Input values exceeding 12 bits are masked to 12 bits.
+// Returns [data(12-bit) | parity(11-bit) | overall parity(1-bit)] as a 24-bit value,
+// where the overall parity bit makes the weight of the codeword even.
+func EncodeExtended(data uint16) uint32 {
+	c := EncodeWord(data)
+	return c<<1 | uint32(bits.OnesCount32(c)&1)
+}
+
+// DecodeExtended decodes a 24-bit extended Golay(24,12) codeword into 12-bit data.
+// Input values exceeding 24 bits are masked to 24 bits.
+// Corrects up to 3-bit errors and detects 4-bit errors.
+// ok is false if a 4-bit error was detected; data then holds the data bits as received.
+func DecodeExtended(codeword uint32) (data uint16, ok bool) {
+	codeword &= 0xFFFFFF
+	c := codeword >> 1
+	odd := bits.OnesCount32(codeword)&1 == 1
+	syndrome := syndromeOf(c)
+	if syndrome == 0 {
+		// no error, or a single error in the overall parity bit
+		return uint16(c >> 11), true
+	}
+	pattern := corrections[syndrome]
+	w := bits.OnesCount32(pattern)
+	if w%2 == 1 != odd {
+		// the overall parity bit is also wrong: w+1 errors in total
+		if w == 3 {
+			return uint16(c >> 11), false
+		}
+	}
+	return uint16((c ^ pattern) >> 11), true
+}
+
+// detectedExtended reports whether DecodeExtended detects an uncorrectable error in
+// the 24-bit codeword: 3 corrections are needed but the overall parity is even.
+func detectedExtended(codeword uint32) bool {
+	syndrome := syndromeOf(codeword >> 1 & 0x7FFFFF)
+	return syndrome != 0 && bits.OnesCount32(corrections[syndrome]) == 3 &&
+		bits.OnesCount32(codeword&0xFFFFFF)%2 == 0
+}
@@ -0,0 +1,43 @@
+package golay
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestExtended(t *testing.T) {
+	var max uint16 = 1<<12 - 1
+	for d := range max {
+		c := EncodeExtended(d)
+		if c>>1 != EncodeWord(d) {
+			t.Fatalf("EncodeExtended failed for data %d: got %#x", d, c)
+		}
+		if bits.OnesCount32(c)%2 != 0 {
+			t.Fatalf("EncodeExtended failed for data %d: odd weight %#x", d, c)
+		}
+		if r, ok := DecodeExtended(c); r != d || !ok {
+			t.Fatalf("DecodeExtended failed for data %d: got %d, %v", d, r, ok)
+		}
+		// every error pattern of weight 1 to 3 is corrected
+		for i := range 24 {
+			if r, ok := DecodeExtended(c ^ 1<<i); r != d || !ok {
+				t.Fatalf("DecodeExtended failed for data %d with 1-bit error at %d: got %d, %v", d, i, r, ok)
+			}
+			for j := i + 1; j < 24; j++ {
+				if r, ok := DecodeExtended(c ^ 1<<i ^ 1<<j); r != d || !ok {
+					t.Fatalf("DecodeExtended failed for data %d with 2-bit errors at %d, %d: got %d, %v", d, i, j, r, ok)
+				}
+			}
+		}
+		// sample 3-bit and 4-bit errors; all 4-bit errors are detected
+		for i := range 24 {
+			j, k, l := (i+5)%24, (i+11)%24, (i+17)%24
+			if r, ok := DecodeExtended(c ^ 1<<i ^ 1<<j ^ 1<<k); r != d || !ok {
+				t.Fatalf("DecodeExtended failed for data %d with 3-bit errors at %d, %d, %d: got %d, %v", d, i, j, k, r, ok)
+			}
+			if _, ok := DecodeExtended(c ^ 1<<i ^ 1<<j ^ 1<<k ^ 1<<l); ok {
+				t.Fatalf("DecodeExtended failed for data %d: 4-bit errors at %d, %d, %d, %d not detected", d, i, j, k, l)
+			}
+		}
+	}
+}
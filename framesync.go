@@ -0,0 +1,322 @@
+package golay
+
+import (
+	"errors"
+	"math/bits"
+	"reflect"
+)
+
+// ErrNoLock is returned by Synchronizer.Acquire when no alignment passes the lock criteria.
+var ErrNoLock = errors.New("golay: no codeword alignment found")
+
+// Alignment is a codeword alignment found by a Synchronizer.
+type Alignment struct {
+	// Offset is the bit offset of the first 23-bit block in the input.
+	Offset int
+	// Score is the mean correction weight per block over the scoring window:
+	// 0 if every block is a valid codeword, about 2.84 for random bits.
+	Score float64
+	// Margin is the difference between the score of the second best offset and Score.
+	// It is 0 if there was only one offset to score or the alignment was found by a sync word.
+	Margin float64
+}
+
+// Segment is a run of blocks decoded by a Synchronizer at one alignment.
+type Segment struct {
+	// Offset is the bit offset of the first block of the segment in the input.
+	Offset int
+	// Blocks is the number of decoded blocks.
+	Blocks int
+	// Output is the bit offset of the decoded data of the segment in the output.
+	Output int
+}
+
+// Synchronizer finds the codeword alignment of an unaligned bitstream, for example
+// when reception starts mid-stream, and decodes it.
+//
+// Each of the 23 candidate offsets is scored by the mean correction weight of the blocks
+// in a window: aligned blocks are codewords with few errors, while misaligned blocks
+// look like random words that need about 2.84 corrections on average.
+// Data that is itself cyclic, such as long runs of zero codewords, is valid at every
+// offset; a coset mask XORed into every codeword by the transmitter, or a known sync word,
+// removes this ambiguity.
+//
+// Because the code is cyclic, offsets one or two bits away from the alignment also score
+// low (about 0.5 and 1.0), so an alignment is only locked if it scores strictly lower
+// than every other candidate.
+//
+// With a sync word, a segment ends where the next sync word is found at a block boundary,
+// and the frame that follows is locked right after it.
+//
+// After locking, the Synchronizer keeps monitoring the score over the most recent
+// window of blocks. A slip is detected when the score exceeds the slip threshold, or when
+// an offset up to two bits away scores lower over the same window. The Synchronizer then
+// discards the blocks of that window and re-acquires the alignment from its start,
+// so a segment after a slip may begin with a few blocks from before the slip.
+type Synchronizer struct {
+	buf        []uint8
+	bits       int
+	window     int
+	coset      uint32
+	syncWord   uint64
+	syncBits   int
+	syncErrors int
+	lock, slip float64
+	segments   []Segment
+}
+
+// NewSynchronizer creates a new Synchronizer for MSB-aligned data, which is copied.
+// The bits parameter specifies how many bits in the input data are valid.
+// If bits is 0, all bits in the data are considered valid.
+// The defaults are a window of 16 blocks, a lock threshold of 0.5 and a slip threshold of 1.5.
+func NewSynchronizer[T BinaryValue](data []T, bits int) *Synchronizer {
+	n := validBits(data, bits)
+	buf := make([]uint8, (n+7)/8)
+	for pos := 0; pos < n; pos += 32 {
+		k := min(32, n-pos)
+		putBits(buf, pos, k, getBits(data, pos, k))
+	}
+	return &Synchronizer{
+		buf:    buf,
+		bits:   n,
+		window: 16,
+		lock:   0.5,
+		slip:   1.5,
+	}
+}
+
+// SetWindow sets the number of blocks used to score an alignment. The default is 16.
+// Larger windows lock more reliably on noisy channels but need more input
+// and react more slowly to slips. Values below 1 are raised to 1.
+func (s *Synchronizer) SetWindow(blocks int) {
+	s.window = max(blocks, 1)
+}
+
+// SetCosetMask sets a 23-bit pattern that the transmitter XORs into every codeword.
+// It is removed before scoring and decoding. The default is 0.
+func (s *Synchronizer) SetCosetMask(mask uint32) {
+	s.coset = mask & 0x7FFFFF
+}
+
+// SetSyncWord sets a known pattern of n bits (up to 64, right-aligned in word) that
+// immediately precedes the first block of the stream, or of each frame. Candidate alignments are then the positions
+// after every occurrence of the sync word with at most maxErrors differing bits,
+// instead of the 23 offsets. A zero n removes the sync word.
+func (s *Synchronizer) SetSyncWord(word uint64, n, maxErrors int) {
+	n = min(max(n, 0), 64)
+	if n < 64 {
+		word &= 1<<n - 1
+	}
+	s.syncWord, s.syncBits, s.syncErrors = word, n, maxErrors
+}
+
+// SetThresholds sets the lock and slip thresholds, in mean correction weight per block.
+// An alignment is locked if its score is at most lock and strictly lower than the score of
+// every other candidate; a slip is detected when the score of the most recent window exceeds slip.
+func (s *Synchronizer) SetThresholds(lock, slip float64) {
+	s.lock, s.slip = lock, slip
+}
+
+// Acquire returns the alignment of the input without decoding it.
+// It scans the input in steps of one block until an alignment passes the lock criteria,
+// and returns ErrNoLock if none does.
+func (s *Synchronizer) Acquire() (Alignment, error) {
+	if a, ok := s.scan(0); ok {
+		return a, nil
+	}
+	return Alignment{}, ErrNoLock
+}
+
+// Decode synchronizes to the input, decodes every block in locked segments and stores
+// the decoded data in v. v must be a pointer to a slice of BinaryValue type.
+// The decoded data of all segments is concatenated; Segments describes where each
+// segment starts in the input and in the output. If the input never locks,
+// v is set to an empty slice and ErrNoLock is returned.
+func (s *Synchronizer) Decode(v any) error {
+	writer, rv, err := newOutputWriter(v)
+	if err != nil {
+		return err
+	}
+
+	s.segments = nil
+	out := 0
+	for pos := 0; ; {
+		a, ok := s.scan(pos)
+		if !ok {
+			break
+		}
+		seg := Segment{Offset: a.Offset, Output: out}
+		var data []uint16
+		var weights []int
+		sum := 0
+		slipped, framed := false, false
+		p := a.Offset
+		for ; p+23 <= s.bits; p += 23 {
+			if len(data) > 0 && s.frameAt(p) {
+				// the next frame starts here and is re-acquired from its sync word
+				framed = true
+				break
+			}
+			w, d := s.block(p)
+			data = append(data, d)
+			weights = append(weights, w)
+			sum += w
+			if len(weights) > s.window {
+				sum -= weights[len(weights)-s.window-1]
+			}
+			if len(weights) < s.window {
+				continue
+			}
+			sc := float64(sum) / float64(s.window)
+			if sc > s.slip || sum > 0 && s.drifted(p+23-s.window*23, sc) {
+				slipped = true
+				break
+			}
+		}
+		switch {
+		case framed:
+			pos = p
+		case slipped:
+			// discard the window in which the slip was detected and re-acquire from its start
+			data = data[:len(data)-s.window]
+			pos = p + 23 - s.window*23
+		}
+		for _, d := range data {
+			// right 12 bits are data
+			writer.Write16(4, 12, d)
+		}
+		seg.Blocks = len(data)
+		out += len(data) * 12
+		if seg.Blocks > 0 {
+			s.segments = append(s.segments, seg)
+		}
+		if !slipped && !framed {
+			break
+		}
+		pos = max(pos, a.Offset+1)
+	}
+	rv.Elem().Set(reflect.ValueOf(writer.AnyData()))
+	if s.segments == nil {
+		return ErrNoLock
+	}
+	return nil
+}
+
+// Segments returns the locked segments of the last Decode call in input order.
+// Consecutive segments are separated by a detected bit slip or, with a sync word, a frame boundary.
+func (s *Synchronizer) Segments() []Segment {
+	return s.segments
+}
+
+// block returns the correction weight and decoded data of the 23-bit block at bit position p.
+func (s *Synchronizer) block(p int) (int, uint16) {
+	cw := getBits(s.buf, p, 23) ^ s.coset
+	syndrome := syndromeOf(cw)
+	if syndrome == 0 {
+		return 0, uint16(cw >> 11)
+	}
+	pattern := corrections[syndrome]
+	return bits.OnesCount32(pattern), uint16((cw ^ pattern) >> 11)
+}
+
+// score returns the mean correction weight of the window of blocks starting at bit position p.
+func (s *Synchronizer) score(p int) float64 {
+	sum := 0
+	for i := range s.window {
+		w, _ := s.block(p + i*23)
+		sum += w
+	}
+	return float64(sum) / float64(s.window)
+}
+
+// frameAt reports whether a sync word starts at bit position p and is followed by
+// a window of blocks within the lock threshold. It is always false without a sync word.
+func (s *Synchronizer) frameAt(p int) bool {
+	if s.syncBits == 0 || p+s.syncBits+s.window*23 > s.bits {
+		return false
+	}
+	if bits.OnesCount64(s.syncAt(p)^s.syncWord) > s.syncErrors {
+		return false
+	}
+	return s.score(p+s.syncBits) <= s.lock
+}
+
+// drifted reports whether an offset up to two bits away from the window at bit position p
+// scores lower than sc, which indicates a slip of one or two bits.
+func (s *Synchronizer) drifted(p int, sc float64) bool {
+	for _, d := range []int{-2, -1, 1, 2} {
+		if q := p + d; q >= 0 && q+s.window*23 <= s.bits && s.score(q) < sc {
+			return true
+		}
+	}
+	return false
+}
+
+// scan searches for a locked alignment at or after bit position pos,
+// advancing one block at a time.
+func (s *Synchronizer) scan(pos int) (Alignment, bool) {
+	span := s.window * 23
+	for start := pos; start+span <= s.bits; start += 23 {
+		if a, ok := s.acquire(start); ok {
+			return a, true
+		}
+		if s.syncBits > 0 {
+			// acquire searches for the sync word over the rest of the input
+			break
+		}
+	}
+	return Alignment{}, false
+}
+
+// acquire scores the candidate alignments at or after bit position start.
+// Without a sync word the candidates are the 23 offsets from start, and the best one is
+// locked if it is within the lock threshold and strictly lower than all others. With a sync word the candidates
+// are the positions after each occurrence of the sync word, and the first one within
+// the lock threshold is locked.
+func (s *Synchronizer) acquire(start int) (Alignment, bool) {
+	span := s.window * 23
+	if s.syncBits > 0 {
+		for p := start; p+s.syncBits+span <= s.bits; p++ {
+			if bits.OnesCount64(s.syncAt(p)^s.syncWord) > s.syncErrors {
+				continue
+			}
+			if sc := s.score(p + s.syncBits); sc <= s.lock {
+				return Alignment{Offset: p + s.syncBits, Score: sc}, true
+			}
+		}
+		return Alignment{}, false
+	}
+
+	best, second := Alignment{Offset: -1}, -1.0
+	for off := range 23 {
+		c := start + off
+		if c+span > s.bits {
+			break
+		}
+		sc := s.score(c)
+		switch {
+		case best.Offset < 0 || sc < best.Score:
+			if best.Offset >= 0 {
+				second = best.Score
+			}
+			best = Alignment{Offset: c, Score: sc}
+		case second < 0 || sc < second:
+			second = sc
+		}
+	}
+	if best.Offset < 0 || best.Score > s.lock || second >= 0 && second <= best.Score {
+		return Alignment{}, false
+	}
+	if second >= 0 {
+		best.Margin = second - best.Score
+	}
+	return best, true
+}
+
+// syncAt returns the syncBits bits at bit position p.
+func (s *Synchronizer) syncAt(p int) uint64 {
+	if s.syncBits <= 32 {
+		return uint64(getBits(s.buf, p, s.syncBits))
+	}
+	return uint64(getBits(s.buf, p, s.syncBits-32))<<32 | uint64(getBits(s.buf, p+s.syncBits-32, 32))
+}
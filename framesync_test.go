@@ -0,0 +1,171 @@
+package golay
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSynchronizer(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	// stream builds an MSB-aligned bitstream from pieces of (value, bits)
+	type piece struct {
+		v uint32
+		n int
+	}
+	stream := func(pieces ...piece) ([]uint8, int) {
+		n := 0
+		for _, p := range pieces {
+			n += p.n
+		}
+		buf := make([]uint8, (n+7)/8)
+		pos := 0
+		for _, p := range pieces {
+			putBits(buf, pos, p.n, p.v&(1<<p.n-1))
+			pos += p.n
+		}
+		return buf, n
+	}
+	codewords := func(data []uint16, coset uint32) []piece {
+		pieces := make([]piece, len(data))
+		for i, d := range data {
+			pieces[i] = piece{EncodeWord(d) ^ coset, 23}
+		}
+		return pieces
+	}
+	randomData := func(n int) []uint16 {
+		data := make([]uint16, n)
+		for i := range data {
+			data[i] = uint16(rng.Intn(4096))
+		}
+		return data
+	}
+	blocks := func(out []uint16, bits int) []uint16 {
+		// unpack 12-bit blocks from the MSB-aligned output
+		var data []uint16
+		for pos := 0; pos+12 <= bits; pos += 12 {
+			data = append(data, uint16(getBits(out, pos, 12)))
+		}
+		return data
+	}
+
+	t.Run("Acquire", func(t *testing.T) {
+		data := randomData(40)
+		for _, skip := range []int{0, 1, 7, 22} {
+			buf, n := stream(append([]piece{{rng.Uint32(), skip}}, codewords(data, 0)...)...)
+			// a few bit errors
+			buf[10] ^= 0x11
+			s := NewSynchronizer(buf, n)
+			a, err := s.Acquire()
+			if err != nil || a.Offset != skip || a.Margin <= 0 {
+				t.Fatalf("Acquire with %d leading bits failed: got (%+v, %v)", skip, a, err)
+			}
+			var out []uint16
+			if err := s.Decode(&out); err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if got := blocks(out, 40*12); !slices.Equal(got, data) {
+				t.Errorf("Decode with %d leading bits failed: got %#x, want %#x", skip, got, data)
+			}
+		}
+	})
+	t.Run("Slip", func(t *testing.T) {
+		// one inserted bit is caught by the neighbouring offsets, five by the slip threshold
+		for _, inserted := range []piece{{0b1, 1}, {0b10110, 5}} {
+			a, b := randomData(60), randomData(60)
+			pieces := append(codewords(a, 0), inserted)
+			pieces = append(pieces, codewords(b, 0)...)
+			buf, n := stream(pieces...)
+			s := NewSynchronizer(buf, n)
+			var out []uint16
+			if err := s.Decode(&out); err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			segs := s.Segments()
+			// the second segment is aligned to the second stream and starts at most one window before it
+			start := 60*23 + inserted.n
+			if len(segs) != 2 || segs[0].Offset != 0 || segs[1].Offset%23 != start%23 ||
+				segs[1].Offset > start || segs[1].Offset < start-16*23 {
+				t.Fatalf("Segments() with %d inserted bits failed: got %+v, want segments at 0 and up to %d", inserted.n, segs, start)
+			}
+			got := blocks(out, (segs[0].Blocks+segs[1].Blocks)*12)
+			if !slices.Equal(got[:segs[0].Blocks], a[:segs[0].Blocks]) || segs[0].Blocks < 40 {
+				t.Errorf("first segment with %d inserted bits failed: %d blocks, want a prefix of the first stream", inserted.n, segs[0].Blocks)
+			}
+			if tail := got[len(got)-len(b):]; !slices.Equal(tail, b) {
+				t.Errorf("second segment with %d inserted bits failed: got %#x, want %#x", inserted.n, tail, b)
+			}
+		}
+	})
+	t.Run("CosetMask", func(t *testing.T) {
+		// zero codewords are valid at every offset
+		zeros := make([]uint16, 40)
+		buf, n := stream(append([]piece{{0x5, 3}}, codewords(zeros, 0)...)...)
+		if _, err := NewSynchronizer(buf, n).Acquire(); !errors.Is(err, ErrNoLock) {
+			t.Errorf("Acquire of zero codewords failed: got %v, want %v", err, ErrNoLock)
+		}
+		const coset = 0x5A5A5A
+		buf, n = stream(append([]piece{{0x5, 3}}, codewords(zeros, coset)...)...)
+		s := NewSynchronizer(buf, n)
+		s.SetCosetMask(coset)
+		if a, err := s.Acquire(); err != nil || a.Offset != 3 {
+			t.Errorf("Acquire with coset mask failed: got (%+v, %v), want offset 3", a, err)
+		}
+	})
+	t.Run("SyncWord", func(t *testing.T) {
+		zeros := make([]uint16, 40)
+		const sync = 0x1ACFFC1D
+		buf, n := stream(append([]piece{{rng.Uint32(), 13}, {sync ^ 0x100, 32}}, codewords(zeros, 0)...)...)
+		s := NewSynchronizer(buf, n)
+		s.SetSyncWord(sync, 32, 2)
+		a, err := s.Acquire()
+		if err != nil || a.Offset != 45 {
+			t.Fatalf("Acquire with sync word failed: got (%+v, %v), want offset 45", a, err)
+		}
+		var out []uint8
+		if err := s.Decode(&out); err != nil || len(out) != 40*12/8 {
+			t.Errorf("Decode failed: got (%d bytes, %v), want %d bytes", len(out), err, 40*12/8)
+		}
+	})
+	t.Run("Frames", func(t *testing.T) {
+		// every frame is a sync word followed by 40 codewords
+		const sync = 0x1ACFFC1D
+		var pieces []piece
+		var want []uint16
+		for i := range 3 {
+			data := randomData(40)
+			want = append(want, data...)
+			word := uint32(sync)
+			if i == 1 {
+				word ^= 0x80001 // 2 bit errors in the sync word
+			}
+			pieces = append(pieces, piece{word, 32})
+			pieces = append(pieces, codewords(data, 0)...)
+		}
+		buf, n := stream(pieces...)
+		s := NewSynchronizer(buf, n)
+		s.SetSyncWord(sync, 32, 2)
+		var out []uint16
+		if err := s.Decode(&out); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		wantSegs := []Segment{{32, 40, 0}, {32 + 952, 40, 480}, {32 + 2*952, 40, 960}}
+		if !slices.Equal(s.Segments(), wantSegs) {
+			t.Errorf("Segments() failed: got %+v, want %+v", s.Segments(), wantSegs)
+		}
+		if got := blocks(out, 120*12); !slices.Equal(got, want) {
+			t.Errorf("Decode failed: got %#x, want %#x", got, want)
+		}
+	})
+	t.Run("NoLock", func(t *testing.T) {
+		buf := make([]uint8, 200)
+		for i := range buf {
+			buf[i] = uint8(rng.Intn(256))
+		}
+		var out []uint8
+		if err := NewSynchronizer(buf, 0).Decode(&out); !errors.Is(err, ErrNoLock) || len(out) != 0 {
+			t.Errorf("Decode of random bits failed: got (%d bytes, %v), want (0, %v)", len(out), err, ErrNoLock)
+		}
+	})
+}
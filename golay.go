@@ -34,16 +34,23 @@ func Decode(codeword uint32) uint16 {
 	// Mask to 23 bits (0b11111111111111111111111 = 0x7FFFFF)
 	codeword &= 0x7FFFFF
 
+	syndrome := syndromeOf(codeword)
+	if syndrome == 0 {
+		return uint16(codeword >> 11)
+	}
+	return uint16((codeword ^ corrections[syndrome]) >> 11)
+}
+
+// syndromeOf calculates the 11-bit syndrome of a 23-bit word.
+// A zero syndrome means the word is a valid codeword.
+func syndromeOf(codeword uint32) uint16 {
 	var syndrome uint16
 	for i := range 11 {
 		if onesCount := bits.OnesCount32(codeword & h[i]); onesCount%2 == 1 {
 			syndrome += 1 << (10 - i)
 		}
 	}
-	if syndrome == 0 {
-		return uint16(codeword >> 11)
-	}
-	return uint16((codeword ^ corrections[syndrome]) >> 11)
+	return syndrome
 }
 
 // g is the generator matrix (11 rows for parity calculation)
@@ -0,0 +1,183 @@
+// Package golaytest provides fault injection utilities for testing pipelines
+// built on the golay package.
+//
+// An Injector corrupts a bit stream by flipping bits at random, injecting bursts,
+// and dropping or inserting bits. Every fault is recorded, so tests can check that
+// the damage stayed within what Golay(23,12) guarantees to correct and assert that
+// the decoded output matches.
+package golaytest
+
+import (
+	"math/rand/v2"
+	"reflect"
+
+	"github.com/yyyoichi/golay"
+)
+
+// Kind is the type of a fault.
+type Kind int
+
+const (
+	// Flip inverts a bit.
+	Flip Kind = iota
+	// Drop removes a bit from the stream.
+	Drop
+	// Insert adds a random bit to the stream.
+	Insert
+)
+
+// String returns the name of the fault kind.
+func (k Kind) String() string {
+	switch k {
+	case Flip:
+		return "flip"
+	case Drop:
+		return "drop"
+	case Insert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// Fault records a single corruption.
+type Fault struct {
+	Kind Kind
+	// In is the position of the affected bit in the input stream.
+	// For Insert it is the position of the input bit that follows the inserted bit.
+	In int
+	// Out is the position of the affected bit in the output stream.
+	// For Drop it is the position the dropped bit would have had.
+	Out int
+}
+
+// Config controls which faults an Injector produces.
+// All rates are probabilities per input bit.
+type Config struct {
+	// FlipRate is the probability that a bit is flipped independently of bursts.
+	FlipRate float64
+	// BurstRate is the probability that a burst starts at a bit.
+	BurstRate float64
+	// BurstLen is the number of consecutive bits flipped by a burst.
+	BurstLen int
+	// DropRate is the probability that a bit is dropped.
+	DropRate float64
+	// InsertRate is the probability that a random bit is inserted before a bit.
+	InsertRate float64
+	// Seed seeds the random number generator.
+	Seed uint64
+}
+
+// Injector applies faults to a bit stream and records them.
+// The stream position carries over between calls, so a single Injector
+// can corrupt a stream that is processed in pieces.
+type Injector struct {
+	cfg    Config
+	rng    *rand.Rand
+	faults []Fault
+	in     int // input bits consumed
+	out    int // output bits produced
+	burst  int // remaining bits of the current burst
+}
+
+// NewInjector creates an Injector with the given configuration.
+func NewInjector(cfg Config) *Injector {
+	return &Injector{
+		cfg: cfg,
+		rng: rand.New(rand.NewPCG(cfg.Seed, cfg.Seed^0x9E3779B97F4A7C15)),
+	}
+}
+
+// Faults returns all faults injected so far, in stream order.
+func (j *Injector) Faults() []Fault {
+	return j.faults
+}
+
+// Flips returns the number of flipped bits in each n-bit block of the output stream,
+// keyed by block index. Blocks without flips are omitted.
+func (j *Injector) Flips(n int) map[int]int {
+	flips := make(map[int]int)
+	for _, f := range j.faults {
+		if f.Kind == Flip {
+			flips[f.Out/n]++
+		}
+	}
+	return flips
+}
+
+// Correctable reports whether every fault injected so far can be corrected by a
+// block code of length n correcting t errors: no bits were dropped or inserted,
+// and no n-bit block has more than t flipped bits.
+// Use Correctable(23, 3) for Golay(23,12).
+func (j *Injector) Correctable(n, t int) bool {
+	for _, f := range j.faults {
+		if f.Kind != Flip {
+			return false
+		}
+	}
+	for _, count := range j.Flips(n) {
+		if count > t {
+			return false
+		}
+	}
+	return true
+}
+
+// next processes one input bit and passes the resulting output bits to emit.
+func (j *Injector) next(bit bool, emit func(bool)) {
+	defer func() { j.in++ }()
+	if j.cfg.InsertRate > 0 && j.rng.Float64() < j.cfg.InsertRate {
+		j.faults = append(j.faults, Fault{Kind: Insert, In: j.in, Out: j.out})
+		emit(j.rng.IntN(2) == 1)
+		j.out++
+	}
+	if j.cfg.DropRate > 0 && j.rng.Float64() < j.cfg.DropRate {
+		j.faults = append(j.faults, Fault{Kind: Drop, In: j.in, Out: j.out})
+		return
+	}
+	if j.burst == 0 && j.cfg.BurstRate > 0 && j.rng.Float64() < j.cfg.BurstRate {
+		j.burst = j.cfg.BurstLen
+	}
+	flip := j.cfg.FlipRate > 0 && j.rng.Float64() < j.cfg.FlipRate
+	if j.burst > 0 {
+		flip = true
+		j.burst--
+	}
+	if flip {
+		j.faults = append(j.faults, Fault{Kind: Flip, In: j.in, Out: j.out})
+		bit = !bit
+	}
+	emit(bit)
+	j.out++
+}
+
+// Corrupt applies faults to the first bits of MSB-aligned data and returns the
+// corrupted data together with its number of valid bits, which differs from bits
+// when bits were dropped or inserted. data is not modified.
+// If bits is 0, all bits in data are considered valid.
+func Corrupt[T golay.BinaryValue](j *Injector, data []T, bits int) ([]T, int) {
+	size := bitSize[T]()
+	if limit := len(data) * size; bits <= 0 || bits > limit {
+		bits = limit
+	}
+	out := make([]T, 0, len(data))
+	n := 0
+	emit := func(bit bool) {
+		if n%size == 0 {
+			out = append(out, 0)
+		}
+		if bit {
+			out[n/size] |= 1 << (size - 1 - n%size)
+		}
+		n++
+	}
+	for i := range bits {
+		j.next(data[i/size]&(1<<(size-1-i%size)) != 0, emit)
+	}
+	return out, n
+}
+
+// bitSize returns the size of T in bits.
+func bitSize[T golay.BinaryValue]() int {
+	return reflect.TypeFor[T]().Bits()
+}
@@ -0,0 +1,104 @@
+package golaytest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/yyyoichi/golay"
+)
+
+func TestInjector(t *testing.T) {
+	t.Run("Flip", func(t *testing.T) {
+		data := make([]uint32, 64)
+		j := NewInjector(Config{FlipRate: 0.05, Seed: 1})
+		got, bits := Corrupt(j, data, 0)
+		if bits != 64*32 {
+			t.Fatalf("Corrupt bits failed: got %d, want %d", bits, 64*32)
+		}
+		var positions []int
+		for i := range bits {
+			if got[i/32]&(1<<(31-i%32)) != 0 {
+				positions = append(positions, i)
+			}
+		}
+		var want []int
+		for _, f := range j.Faults() {
+			if f.Kind != Flip || f.In != f.Out {
+				t.Fatalf("unexpected fault %+v", f)
+			}
+			want = append(want, f.Out)
+		}
+		if len(want) == 0 || !slices.Equal(positions, want) {
+			t.Errorf("Corrupt flips failed: got %v, want %v", positions, want)
+		}
+		if slices.ContainsFunc(data, func(v uint32) bool { return v != 0 }) {
+			t.Errorf("Corrupt modified its input")
+		}
+	})
+	t.Run("Burst", func(t *testing.T) {
+		j := NewInjector(Config{BurstRate: 0.01, BurstLen: 5, Seed: 2})
+		_, _ = Corrupt(j, make([]uint8, 1000), 0)
+		faults := j.Faults()
+		if len(faults) == 0 || len(faults)%5 != 0 {
+			t.Fatalf("burst faults failed: got %d faults, want a multiple of %d", len(faults), 5)
+		}
+		for i := 0; i < len(faults); i += 5 {
+			for k := 1; k < 5; k++ {
+				if faults[i+k].Out != faults[i].Out+k {
+					t.Fatalf("burst faults are not consecutive: %+v", faults[i:i+5])
+				}
+			}
+		}
+	})
+	t.Run("DropInsert", func(t *testing.T) {
+		j := NewInjector(Config{DropRate: 0.01, InsertRate: 0.01, Seed: 3})
+		_, bits := Corrupt(j, make([]uint16, 500), 0)
+		var drops, inserts int
+		for _, f := range j.Faults() {
+			switch f.Kind {
+			case Drop:
+				drops++
+			case Insert:
+				inserts++
+			}
+		}
+		if drops == 0 || inserts == 0 {
+			t.Fatalf("DropInsert failed: got %d drops and %d inserts", drops, inserts)
+		}
+		if bits != 500*16-drops+inserts {
+			t.Errorf("Corrupt bits failed: got %d, want %d", bits, 500*16-drops+inserts)
+		}
+		if j.Correctable(23, 3) {
+			t.Errorf("Correctable() failed: dropped and inserted bits are not correctable")
+		}
+	})
+	t.Run("GolayGuarantee", func(t *testing.T) {
+		correctable := 0
+		for seed := range uint64(50) {
+			data := make([]uint8, 300)
+			for i := range data {
+				data[i] = uint8(rand.Intn(256))
+			}
+			var encoded []uint8
+			enc := golay.NewEncoder(&encoded)
+			_ = enc.Encode(data, 0)
+
+			j := NewInjector(Config{FlipRate: 0.01, Seed: seed})
+			corrupted, bits := Corrupt(j, encoded, enc.Bits())
+			var decoded []uint8
+			_ = golay.NewDecoder(corrupted, bits).Decode(&decoded)
+
+			if !j.Correctable(23, 3) {
+				continue
+			}
+			correctable++
+			if !slices.Equal(decoded, data) {
+				t.Fatalf("seed %d: decoding failed although faults %v are within the guarantee", seed, j.Flips(23))
+			}
+		}
+		if correctable == 0 {
+			t.Fatalf("no correctable run")
+		}
+	})
+}
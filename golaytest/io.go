@@ -0,0 +1,112 @@
+package golaytest
+
+import "io"
+
+// Reader wraps an io.Reader and corrupts the bytes read from it, MSB first.
+// When bits are dropped or inserted, output bytes are realigned; a final partial
+// byte is padded with zero bits at EOF.
+type Reader struct {
+	r   io.Reader
+	j   *Injector
+	buf []byte // corrupted bytes not yet returned
+	acc byte   // partial output byte
+	n   int    // number of bits in acc
+	err error
+}
+
+// NewReader returns a Reader that reads from r and corrupts the data with j.
+func NewReader(r io.Reader, j *Injector) *Reader {
+	return &Reader{r: r, j: j}
+}
+
+// Read implements io.Reader.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		in := make([]byte, max(len(p), 1))
+		n, err := r.r.Read(in)
+		for _, b := range in[:n] {
+			for i := 7; i >= 0; i-- {
+				r.j.next(b&(1<<i) != 0, r.push)
+			}
+		}
+		if err != nil {
+			r.err = err
+			if r.n > 0 {
+				r.buf = append(r.buf, r.acc<<(8-r.n))
+				r.acc, r.n = 0, 0
+			}
+		}
+	}
+	if len(r.buf) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *Reader) push(bit bool) {
+	r.acc <<= 1
+	if bit {
+		r.acc |= 1
+	}
+	r.n++
+	if r.n == 8 {
+		r.buf = append(r.buf, r.acc)
+		r.acc, r.n = 0, 0
+	}
+}
+
+// Writer wraps an io.Writer and corrupts the bytes written to it, MSB first.
+// When bits are dropped or inserted, output bytes are realigned;
+// call Flush to write a final partial byte padded with zero bits.
+type Writer struct {
+	w   io.Writer
+	j   *Injector
+	buf []byte
+	acc byte
+	n   int
+}
+
+// NewWriter returns a Writer that corrupts data with j and writes it to w.
+func NewWriter(w io.Writer, j *Injector) *Writer {
+	return &Writer{w: w, j: j}
+}
+
+// Write implements io.Writer. It reports len(p) bytes written on success,
+// even if the number of corrupted bytes passed to the underlying writer differs.
+func (w *Writer) Write(p []byte) (int, error) {
+	for _, b := range p {
+		for i := 7; i >= 0; i-- {
+			w.j.next(b&(1<<i) != 0, w.push)
+		}
+	}
+	if _, err := w.w.Write(w.buf); err != nil {
+		return 0, err
+	}
+	w.buf = w.buf[:0]
+	return len(p), nil
+}
+
+// Flush writes any buffered partial byte, padded with zero bits.
+func (w *Writer) Flush() error {
+	if w.n == 0 {
+		return nil
+	}
+	b := w.acc << (8 - w.n)
+	w.acc, w.n = 0, 0
+	_, err := w.w.Write([]byte{b})
+	return err
+}
+
+func (w *Writer) push(bit bool) {
+	w.acc <<= 1
+	if bit {
+		w.acc |= 1
+	}
+	w.n++
+	if w.n == 8 {
+		w.buf = append(w.buf, w.acc)
+		w.acc, w.n = 0, 0
+	}
+}
@@ -0,0 +1,68 @@
+package golaytest
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestIO(t *testing.T) {
+	data := bytes.Repeat([]byte{0xA5}, 100)
+	t.Run("Reader", func(t *testing.T) {
+		j := NewInjector(Config{FlipRate: 0.02, Seed: 1})
+		got, err := io.ReadAll(iotest.OneByteReader(NewReader(bytes.NewReader(data), j)))
+		if err != nil {
+			t.Fatalf("Reader failed: %v", err)
+		}
+		if !bytes.Equal(got, flipAt(data, j.Faults())) {
+			t.Errorf("Reader output does not match recorded faults")
+		}
+	})
+	t.Run("Writer", func(t *testing.T) {
+		j := NewInjector(Config{FlipRate: 0.02, Seed: 1})
+		var buf bytes.Buffer
+		w := NewWriter(&buf, j)
+		for i := range 10 {
+			if n, err := w.Write(data[i*10 : i*10+10]); n != 10 || err != nil {
+				t.Fatalf("Writer.Write failed: got %d, %v", n, err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("Writer.Flush failed: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), flipAt(data, j.Faults())) {
+			t.Errorf("Writer output does not match recorded faults")
+		}
+	})
+	t.Run("Realign", func(t *testing.T) {
+		// dropping every bit produces no output
+		j := NewInjector(Config{DropRate: 1})
+		r := NewReader(bytes.NewReader([]byte{0xFF}), j)
+		if got, _ := io.ReadAll(r); len(got) != 0 || len(j.Faults()) != 8 {
+			t.Fatalf("Reader dropping all bits failed: got %#x with %d faults", got, len(j.Faults()))
+		}
+
+		var buf bytes.Buffer
+		j = NewInjector(Config{DropRate: 0.05, Seed: 4})
+		w := NewWriter(&buf, j)
+		_, _ = w.Write(data)
+		_ = w.Flush()
+		drops := len(j.Faults())
+		if drops == 0 {
+			t.Fatalf("no bits dropped")
+		}
+		if want := (len(data)*8 - drops + 7) / 8; buf.Len() != want {
+			t.Errorf("Writer output length failed: got %d, want %d", buf.Len(), want)
+		}
+	})
+}
+
+// flipAt flips the bits of data at the output positions of faults.
+func flipAt(data []byte, faults []Fault) []byte {
+	out := bytes.Clone(data)
+	for _, f := range faults {
+		out[f.Out/8] ^= 1 << (7 - f.Out%8)
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package golay
+
+import (
+	"context"
+	"log/slog"
+	"math/bits"
+	"sync/atomic"
+)
+
+// Correction describes an error pattern corrected while decoding a 23-bit codeword.
+type Correction struct {
+	// Block is the index of the codeword within a stream.
+	// It is always 0 for word-level decoding.
+	Block int
+	// Syndrome is the 11-bit syndrome of the received word. It is non-zero except for
+	// corrections made with known bits (Decoder.SetKnownBits) to a received word that is
+	// a valid codeword but contradicts the known bits; Pattern is then a non-zero codeword.
+	Syndrome uint16
+	// Pattern is the 23-bit error pattern that was XORed into the received word.
+	Pattern uint32
+}
+
+// Weight returns the number of bits flipped by the correction.
+func (c Correction) Weight() int {
+	return bits.OnesCount32(c.Pattern)
+}
+
+// Positions returns the positions of the flipped bits in the 23-bit codeword.
+// Positions are counted from the MSB, so 0-11 are data bits and 12-22 are parity bits,
+// matching the order in which bits appear in an encoded stream.
+func (c Correction) Positions() []int {
+	positions := make([]int, 0, c.Weight())
+	for i := range 23 {
+		if c.Pattern&(1<<(22-i)) != 0 {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// CorrectionHook is called for every codeword that required error correction.
+type CorrectionHook func(Correction)
+
+// DecodeWithHook decodes a 23-bit Golay(23,12) codeword like Decode
+// and calls hook when an error pattern is corrected.
+// A nil hook is allowed and behaves like Decode.
+func DecodeWithHook(codeword uint32, hook CorrectionHook) uint16 {
+	data, _ := decodeBlock(0, codeword, hook, CorrectAll)
+	return data
+}
+
+// SlogHook returns a CorrectionHook that writes each correction to logger as a structured record.
+// Records are emitted at the given level with the block index, syndrome,
+// flipped bit positions and correction weight as attributes.
+// Only one out of every n corrections is logged; n <= 1 logs every correction.
+// The returned hook is safe for concurrent use.
+func SlogHook(logger *slog.Logger, level slog.Level, n int) CorrectionHook {
+	if logger == nil {
+		panic("logger must not be nil")
+	}
+	var count atomic.Uint64
+	return func(c Correction) {
+		i := count.Add(1) - 1
+		if n > 1 && i%uint64(n) != 0 {
+			return
+		}
+		ctx := context.Background()
+		if !logger.Enabled(ctx, level) {
+			return
+		}
+		logger.LogAttrs(ctx, level, "golay: corrected codeword",
+			slog.Int("block", c.Block),
+			slog.Uint64("syndrome", uint64(c.Syndrome)),
+			slog.Any("flipped", c.Positions()),
+			slog.Int("weight", c.Weight()),
+		)
+	}
+}
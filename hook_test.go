@@ -0,0 +1,126 @@
+package golay
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"testing"
+)
+
+func TestCorrectionHook(t *testing.T) {
+	t.Run("DecodeWithHook", func(t *testing.T) {
+		c := EncodeWord(0b101100110011)
+		var got []Correction
+		hook := func(c Correction) { got = append(got, c) }
+
+		if d := DecodeWithHook(c, hook); d != 0b101100110011 {
+			t.Fatalf("DecodeWithHook failed: got %#x, want %#x", d, 0b101100110011)
+		}
+		if len(got) != 0 {
+			t.Fatalf("DecodeWithHook reported %d corrections for a valid codeword", len(got))
+		}
+
+		// flip bit 0 (MSB of data), bit 11 (LSB of data) and bit 22 (LSB of parity)
+		e := c ^ (1 << 22) ^ (1 << 11) ^ 1
+		if d := DecodeWithHook(e, hook); d != 0b101100110011 {
+			t.Fatalf("DecodeWithHook failed: got %#x, want %#x", d, 0b101100110011)
+		}
+		if len(got) != 1 {
+			t.Fatalf("DecodeWithHook reported %d corrections, want %d", len(got), 1)
+		}
+		if got[0].Pattern != (1<<22)|(1<<11)|1 {
+			t.Errorf("Correction.Pattern failed: got %#x, want %#x", got[0].Pattern, (1<<22)|(1<<11)|1)
+		}
+		if got[0].Syndrome == 0 {
+			t.Errorf("Correction.Syndrome failed: got 0")
+		}
+		if got[0].Weight() != 3 {
+			t.Errorf("Correction.Weight() failed: got %d, want %d", got[0].Weight(), 3)
+		}
+		if pos := got[0].Positions(); !slices.Equal(pos, []int{0, 11, 22}) {
+			t.Errorf("Correction.Positions() failed: got %v, want %v", pos, []int{0, 11, 22})
+		}
+
+		// nil hook behaves like Decode
+		if d := DecodeWithHook(e, nil); d != Decode(e) {
+			t.Errorf("DecodeWithHook with nil hook failed: got %#x, want %#x", d, Decode(e))
+		}
+	})
+	t.Run("Decoder", func(t *testing.T) {
+		// 3 blocks, errors in block 1 and 2
+		var encoded []uint32
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode([]uint16{0xABC0, 0x1230, 0x4560}, 36)
+		flip := func(pos int) {
+			encoded[pos/32] ^= 1 << (31 - pos%32)
+		}
+		flip(23 + 5)
+		flip(46 + 1)
+		flip(46 + 20)
+
+		var got []Correction
+		dec := NewDecoder(encoded, enc.Bits())
+		dec.SetCorrectionHook(func(c Correction) { got = append(got, c) })
+		var decoded []uint16
+		_ = dec.Decode(&decoded)
+		if !slices.Equal(decoded, []uint16{0xABC0, 0x1230, 0x4000}) {
+			t.Fatalf("Decoder.Decode failed: got %#x", decoded)
+		}
+		if len(got) != 2 {
+			t.Fatalf("Decoder reported %d corrections, want %d", len(got), 2)
+		}
+		if got[0].Block != 1 || !slices.Equal(got[0].Positions(), []int{5}) {
+			t.Errorf("Correction failed: got block %d positions %v, want block 1 positions [5]", got[0].Block, got[0].Positions())
+		}
+		if got[1].Block != 2 || !slices.Equal(got[1].Positions(), []int{1, 20}) {
+			t.Errorf("Correction failed: got block %d positions %v, want block 2 positions [1 20]", got[1].Block, got[1].Positions())
+		}
+	})
+	t.Run("SlogHook", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		hook := SlogHook(logger, slog.LevelWarn, 2)
+
+		c := EncodeWord(0x123)
+		for i := range 5 {
+			_, _ = decodeBlock(i, c^(1<<i), hook, CorrectAll)
+		}
+
+		var records []map[string]any
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			var r map[string]any
+			if err := dec.Decode(&r); err != nil {
+				t.Fatalf("failed to parse log record: %v", err)
+			}
+			records = append(records, r)
+		}
+		// every 2nd correction: block 0, 2, 4
+		if len(records) != 3 {
+			t.Fatalf("SlogHook logged %d records, want %d", len(records), 3)
+		}
+		for i, r := range records {
+			if r["level"] != "WARN" {
+				t.Errorf("record %d level failed: got %v, want %v", i, r["level"], "WARN")
+			}
+			if r["block"] != float64(i*2) {
+				t.Errorf("record %d block failed: got %v, want %v", i, r["block"], i*2)
+			}
+			if r["weight"] != float64(1) {
+				t.Errorf("record %d weight failed: got %v, want %v", i, r["weight"], 1)
+			}
+			if flipped, _ := r["flipped"].([]any); len(flipped) != 1 || flipped[0] != float64(22-i*2) {
+				t.Errorf("record %d flipped failed: got %v, want [%d]", i, r["flipped"], 22-i*2)
+			}
+		}
+
+		// records below the logger's level are not emitted
+		buf.Reset()
+		quiet := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelError}))
+		_ = DecodeWithHook(c^1, SlogHook(quiet, slog.LevelInfo, 1))
+		if buf.Len() != 0 {
+			t.Errorf("SlogHook logged below handler level: %s", buf.String())
+		}
+	})
+}
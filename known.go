@@ -0,0 +1,47 @@
+package golay
+
+import "math/bits"
+
+// DecodeKnown decodes a 23-bit Golay(23,12) codeword into 12-bit data, using data bits
+// that are known in advance as constraints. Bits set in mask are known and take their
+// value from value; the decoder returns the data of the closest codeword whose data bits
+// match them. Input values exceeding 23 bits are masked to 23 bits.
+//
+// With k known bits only 2^(12-k) codewords remain, so errors beyond the 3 that Decode
+// corrects can often be corrected. Ties are broken toward the smallest data value.
+// A zero mask behaves like Decode.
+func DecodeKnown(codeword uint32, mask, value uint16) uint16 {
+	data, _ := decodeKnown(codeword, mask, value)
+	return data
+}
+
+// decodeKnown returns the data of the closest codeword consistent with the known bits
+// and the error pattern between the received word and that codeword.
+func decodeKnown(codeword uint32, mask, value uint16) (uint16, uint32) {
+	codeword &= 0x7FFFFF
+	mask &= 0xFFF
+	value &= mask
+	syndrome := syndromeOf(codeword)
+	pattern := corrections[syndrome]
+	if syndrome == 0 {
+		pattern = 0
+	}
+	// the nearest codeword is within distance 3 and every other codeword is at least 4 away
+	if data := uint16((codeword ^ pattern) >> 11); data&mask == value {
+		return data, pattern
+	}
+
+	free := ^mask & 0xFFF
+	best, bestPattern, bestDist := uint16(0), uint32(0), 24
+	for sub := uint16(0); ; sub = (sub - free) & free {
+		data := value | sub
+		e := codeword ^ EncodeWord(data)
+		if d := bits.OnesCount32(e); d < bestDist {
+			best, bestPattern, bestDist = data, e, d
+		}
+		if sub == free {
+			break
+		}
+	}
+	return best, bestPattern
+}
@@ -0,0 +1,117 @@
+package golay
+
+import (
+	"errors"
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestDecodeKnown(t *testing.T) {
+	t.Run("ZeroMask", func(t *testing.T) {
+		for d := range uint16(4096) {
+			c := EncodeWord(d) ^ uint32(CosetLeader(d%2048))
+			if got, want := DecodeKnown(c, 0, 0), Decode(c); got != want {
+				t.Fatalf("DecodeKnown(%06x, 0, 0) failed: got %03x, want %03x", c, got, want)
+			}
+		}
+	})
+	t.Run("BeyondThreeErrors", func(t *testing.T) {
+		const data = 0x5A3
+		c := EncodeWord(data)
+		// 4 and 5 errors, which Decode miscorrects
+		for _, e := range []uint32{0x400842, 0x421084, 0x401801, 0x600201} {
+			if Decode(c^e) == data {
+				t.Fatalf("Decode(%06x) unexpectedly corrected %d errors", c^e, bits.OnesCount32(e))
+			}
+			// the upper 8 data bits are known
+			if got := DecodeKnown(c^e, 0xFF0, data); got != data {
+				t.Errorf("DecodeKnown(%06x, 0xFF0) failed: got %03x, want %03x", c^e, got, data)
+			}
+		}
+	})
+	t.Run("Closest", func(t *testing.T) {
+		// the result is a closest codeword among those consistent with the known bits
+		for _, e := range []uint32{0x7, 0x400842, 0x421084, 0x7F0000} {
+			received := EncodeWord(0x123) ^ e
+			got := DecodeKnown(received, 0xF0F, 0x103)
+			if got&0xF0F != 0x103 {
+				t.Fatalf("DecodeKnown(%06x) failed: got %03x, inconsistent with known bits", received, got)
+			}
+			dist := bits.OnesCount32(received ^ EncodeWord(got))
+			for d := range uint16(4096) {
+				if d&0xF0F == 0x103 && bits.OnesCount32(received^EncodeWord(d)) < dist {
+					t.Fatalf("DecodeKnown(%06x) failed: got %03x at distance %d, %03x is closer", received, got, dist, d)
+				}
+			}
+		}
+	})
+	t.Run("ValidCodeword", func(t *testing.T) {
+		var encoded []uint8
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode([]uint8{0xA5, 0x3C, 0x96}, 0)
+		// block 0 is replaced by a valid codeword that contradicts the known first byte
+		diff := EncodeWord(0xA53) ^ EncodeWord(0xA43)
+		for p := range 23 {
+			if diff&(1<<(22-p)) != 0 {
+				encoded[p/8] ^= 0x80 >> (p % 8)
+			}
+		}
+
+		var got []Correction
+		var plain []uint8
+		dec := NewDecoder(encoded, enc.Bits())
+		dec.SetCorrectionHook(func(c Correction) { got = append(got, c) })
+		dec.SetKnownBits([]uint8{0xFF}, []uint8{0xA5})
+		if err := dec.Decode(&plain); err != nil || plain[0] != 0xA5 {
+			t.Fatalf("Decoder.Decode failed: got (%#x, %v), want first byte 0xa5", plain, err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Decoder failed: got corrections %v, want 1", got)
+		}
+		if c := got[0]; c.Block != 0 || c.Syndrome != 0 || c.Pattern == 0 || syndromeOf(c.Pattern) != 0 {
+			t.Errorf("Correction failed: got %+v, want block 0, syndrome 0 and a non-zero codeword pattern", c)
+		}
+	})
+	t.Run("Decoder", func(t *testing.T) {
+		var encoded []uint8
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode([]uint8{0xA5, 0x3C, 0x96}, 0)
+		// 4 errors in block 0, 1 error in block 1
+		for _, p := range []int{1, 6, 11, 17, 30} {
+			encoded[p/8] ^= 0x80 >> (p % 8)
+		}
+		// the first byte is a known header
+		mask := []uint8{0xFF}
+		value := []uint8{0xA5}
+
+		var plain []uint8
+		_ = NewDecoder(encoded, enc.Bits()).Decode(&plain)
+		if slices.Equal(plain, []uint8{0xA5, 0x3C, 0x96}) {
+			t.Fatal("Decoder unexpectedly corrected 4 errors")
+		}
+		// CorrectAll accepts the 4-error correction made with the known bits
+		var got []uint8
+		dec := NewDecoder(encoded, enc.Bits())
+		var weights []int
+		dec.SetCorrectionHook(func(c Correction) { weights = append(weights, c.Weight()) })
+		dec.SetKnownBits(mask, value)
+		if err := dec.Decode(&got); err != nil || !slices.Equal(got, []uint8{0xA5, 0x3C, 0x96}) {
+			t.Errorf("Decoder.Decode failed: got (%#x, %v), want %#x", got, err, []uint8{0xA5, 0x3C, 0x96})
+		}
+		if !slices.Equal(weights, []int{4, 1}) {
+			t.Errorf("Decoder failed: got corrections %v, want [4 1]", weights)
+		}
+
+		// DetectOnly rejects both blocks, which keep their received data bits
+		weights = nil
+		dec.SetPolicy(DetectOnly)
+		err := dec.Decode(&got)
+		if want := []uint8{0xA5 ^ 0x42, 0x3C ^ 0x10, 0x96 ^ 0x10}; !slices.Equal(got, want) {
+			t.Errorf("Decoder.Decode with DetectOnly failed: got %#x, want %#x", got, want)
+		}
+		if !errors.Is(err, ErrRejected) || len(weights) != 0 || !slices.Equal(dec.Rejected(), []int{0, 1}) {
+			t.Errorf("Decoder with DetectOnly failed: got (%v, corrections %v, rejected %v), want (%v, [], [0 1])", err, weights, dec.Rejected(), ErrRejected)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package golay
+
+import "fmt"
+
+// LengthMode selects how a Decoder handles input lengths that are not a whole number of blocks.
+type LengthMode int
+
+const (
+	// IgnoreRemainder decodes the complete blocks and drops any remaining bits.
+	// A bits argument larger than the data is capped to the data. It is the default mode.
+	IgnoreRemainder LengthMode = iota
+	// StrictLength rejects inputs that are not a whole number of blocks:
+	// Decode returns an error wrapping ErrBitsExceedData if the bits argument of NewDecoder
+	// is larger than the data, or ErrTruncated if bits is not a multiple of the codeword
+	// length, 23 or 24 with Decoder.SetExtended.
+	// If bits is 0, only the padding of the last byte may follow the last block, and
+	// data with elements wider than 8 bits returns an error wrapping ErrBitsRequired,
+	// since their padding cannot be told apart from truncated or overlong input.
+	// Nothing is decoded in any of these cases.
+	StrictLength
+	// LenientLength decodes the complete blocks like IgnoreRemainder and keeps the
+	// remaining bits, which Decoder.Remainder returns for reassembly with later data.
+	LenientLength
+)
+
+// String returns the name of the length mode.
+func (m LengthMode) String() string {
+	switch m {
+	case IgnoreRemainder:
+		return "IgnoreRemainder"
+	case StrictLength:
+		return "StrictLength"
+	case LenientLength:
+		return "LenientLength"
+	default:
+		return "LengthMode(unknown)"
+	}
+}
+
+// checkLength validates the requested and available input bits under mode.
+// requested is the bits argument of NewDecoder, 0 meaning all available bits,
+// size is the element size of the data in bits and block the codeword length.
+func checkLength(mode LengthMode, requested, available, size, block int) error {
+	if mode != StrictLength {
+		return nil
+	}
+	if requested > available {
+		return fmt.Errorf("%w: %d bits requested, data holds %d bits", ErrBitsExceedData, requested, available)
+	}
+	if requested > 0 {
+		if r := requested % block; r != 0 {
+			return fmt.Errorf("%w: %d bits is %d blocks and %d trailing bits", ErrTruncated, requested, requested/block, r)
+		}
+		return nil
+	}
+	if size > 8 {
+		return fmt.Errorf("%w: %d-bit elements", ErrBitsRequired, size)
+	}
+	if r := available % block; r >= size {
+		return fmt.Errorf("%w: %d bits is %d blocks and %d trailing bits, more than the %d-bit element padding",
+			ErrTruncated, available, available/block, r, size)
+	}
+	return nil
+}
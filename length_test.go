@@ -0,0 +1,102 @@
+package golay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLengthMode(t *testing.T) {
+	var encoded []uint8
+	enc := NewEncoder(&encoded)
+	_ = enc.Encode([]uint8{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, 0) // 4 blocks, 92 bits in 12 bytes
+
+	t.Run("Strict", func(t *testing.T) {
+		tests := []struct {
+			data []uint8
+			bits int
+			want error
+		}{
+			{encoded, 92, nil},
+			{encoded, 0, nil}, // 4 padding bits
+			{encoded, 46, nil},
+			{encoded, 97, ErrBitsExceedData},
+			{encoded, 90, ErrTruncated},
+			{append(slices.Clone(encoded), 0), 0, ErrTruncated},
+		}
+		for _, tt := range tests {
+			var out []uint8
+			dec := NewDecoder(tt.data, tt.bits)
+			dec.SetLengthMode(StrictLength)
+			err := dec.Decode(&out)
+			if !errors.Is(err, tt.want) || (tt.want == nil) != (err == nil) {
+				t.Errorf("Decode(%d bytes, %d bits) failed: got %v, want %v", len(tt.data), tt.bits, err, tt.want)
+			}
+			if err != nil && out != nil {
+				t.Errorf("Decode(%d bytes, %d bits) failed: got output %#x on error", len(tt.data), tt.bits, out)
+			}
+		}
+	})
+	t.Run("StrictWide", func(t *testing.T) {
+		var wide []uint32
+		e := NewEncoder(&wide)
+		_ = e.Encode([]uint8{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC}, 0) // 92 bits in 3 elements
+		tests := []struct {
+			data []uint32
+			bits int
+			want error
+		}{
+			{wide, 92, nil},
+			{wide, 69, nil},
+			{wide, 0, ErrBitsRequired},
+			{wide[:2], 0, ErrBitsRequired},                      // truncated
+			{append(slices.Clone(wide), 0), 0, ErrBitsRequired}, // overlong
+			{wide[:2], 92, ErrBitsExceedData},
+			{wide, 91, ErrTruncated},
+		}
+		for _, tt := range tests {
+			var out []uint8
+			dec := NewDecoder(tt.data, tt.bits)
+			dec.SetLengthMode(StrictLength)
+			err := dec.Decode(&out)
+			if !errors.Is(err, tt.want) || (tt.want == nil) != (err == nil) {
+				t.Errorf("Decode(%d elements, %d bits) failed: got %v, want %v", len(tt.data), tt.bits, err, tt.want)
+			}
+		}
+	})
+	t.Run("Lenient", func(t *testing.T) {
+		var out []uint8
+		dec := NewDecoder(encoded, 80)
+		dec.SetLengthMode(LenientLength)
+		if err := dec.Decode(&out); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		rem, n := dec.Remainder()
+		if n != 11 || len(rem) != 2 || uint32(rem[0])<<3|uint32(rem[1])>>5 != getBits(encoded, 69, 11) {
+			t.Fatalf("Remainder() failed: got (%#x, %d), want the 11 bits at offset 69", rem, n)
+		}
+		// reassemble the last block from the remainder and the rest of the stream
+		var tail []uint8
+		chunks := NewChunkDecoder(&tail)
+		_ = chunks.Decode(rem, n)
+		rest := make([]uint8, 2)
+		putBits(rest, 0, 12, getBits(encoded, 80, 12))
+		_ = chunks.Decode(rest, 12)
+		if tail[0] != 0xAB || tail[1]>>4 != 0xC {
+			t.Errorf("reassembly failed: got %#x, want 0xabc", tail)
+		}
+	})
+	t.Run("Ignore", func(t *testing.T) {
+		var out []uint8
+		dec := NewDecoder(encoded, 200)
+		if err := dec.Decode(&out); err != nil || dec.Bits() != 48 {
+			t.Errorf("Decode failed: got (%v, %d bits), want (nil, 48 bits)", err, dec.Bits())
+		}
+		if rem, n := dec.Remainder(); rem != nil || n != 0 {
+			t.Errorf("Remainder() failed: got (%#x, %d), want (nil, 0)", rem, n)
+		}
+		if got := LengthMode(7).String(); got != "LengthMode(unknown)" {
+			t.Errorf("LengthMode.String() failed: got %q", got)
+		}
+	})
+}
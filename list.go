@@ -0,0 +1,42 @@
+package golay
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// Candidate is a codeword found by ListDecode.
+type Candidate struct {
+	// Data is the 12-bit data of the codeword.
+	Data uint16
+	// Codeword is the 23-bit codeword.
+	Codeword Codeword
+	// Distance is the Hamming distance between the codeword and the received word.
+	Distance int
+}
+
+// allCodewords is the table of all codewords, built on first use.
+var allCodewords = sync.OnceValue(Codewords)
+
+// ListDecode returns every codeword within Hamming distance radius of the 23-bit received word,
+// sorted by distance and then by data. Input values exceeding 23 bits are masked to 23 bits.
+//
+// Up to radius 3 the list holds at most one codeword, the one Decode returns.
+// From radius 4 a received word can have several candidates at the same distance,
+// which higher layers such as a CRC or known message structure can choose from.
+// A negative radius returns nil.
+func ListDecode(received uint32, radius int) []Candidate {
+	received &= 0x7FFFFF
+	var list []Candidate
+	for d, c := range allCodewords() {
+		if dist := bits.OnesCount32(received ^ uint32(c)); dist <= radius {
+			list = append(list, Candidate{Data: uint16(d), Codeword: c, Distance: dist})
+		}
+	}
+	slices.SortStableFunc(list, func(a, b Candidate) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	return list
+}
@@ -0,0 +1,59 @@
+package golay
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestListDecode(t *testing.T) {
+	t.Run("PackingRadius", func(t *testing.T) {
+		c := EncodeWord(0xABC)
+		for _, e := range []uint32{0, 0x1, 0x400100, 0x7} {
+			list := ListDecode(c^e, 3)
+			if len(list) != 1 || list[0].Data != 0xABC || list[0].Distance != bits.OnesCount32(e) {
+				t.Errorf("ListDecode(%06x, 3) failed: got %v, want one candidate 0xABC", c^e, list)
+			}
+		}
+	})
+	t.Run("Radius4", func(t *testing.T) {
+		// a 4-bit error leaves the transmitted codeword at distance 4 and,
+		// because the code is perfect, exactly one other codeword at distance 3
+		c := EncodeWord(0x123)
+		received := c ^ 0x401041
+		list := ListDecode(received, 4)
+		if len(list) < 2 {
+			t.Fatalf("ListDecode failed: got %d candidates, want at least 2", len(list))
+		}
+		if list[0].Distance != 3 || list[0].Data != Decode(received) {
+			t.Errorf("ListDecode failed: first candidate %+v, want Decode result at distance 3", list[0])
+		}
+		found := false
+		for i, cand := range list {
+			if i > 0 && cand.Distance < list[i-1].Distance {
+				t.Errorf("ListDecode failed: candidates not sorted by distance: %v", list)
+			}
+			if uint32(cand.Codeword) != EncodeWord(cand.Data) || cand.Distance != bits.OnesCount32(received^uint32(cand.Codeword)) {
+				t.Errorf("ListDecode failed: inconsistent candidate %+v", cand)
+			}
+			if cand.Data == 0x123 {
+				found = cand.Distance == 4
+			}
+		}
+		if !found {
+			t.Errorf("ListDecode failed: transmitted codeword not found at distance 4 in %v", list)
+		}
+	})
+	t.Run("Count", func(t *testing.T) {
+		// every word lies within distance 3 of exactly one codeword,
+		// so the list size at radius r is the number of codewords in the ball
+		if got := len(ListDecode(0, 7)); got != 1+253 {
+			t.Errorf("ListDecode(0, 7) failed: got %d candidates, want %d", got, 254)
+		}
+		if got := len(ListDecode(0x7FFFFF, 23)); got != 4096 {
+			t.Errorf("ListDecode(0x7FFFFF, 23) failed: got %d candidates, want %d", got, 4096)
+		}
+		if got := ListDecode(0, -1); got != nil {
+			t.Errorf("ListDecode(0, -1) failed: got %v, want nil", got)
+		}
+	})
+}
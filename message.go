@@ -0,0 +1,220 @@
+package golay
+
+import (
+	"container/heap"
+	"errors"
+	"reflect"
+
+	"github.com/yyyoichi/bitstream-go"
+)
+
+// ErrCheckFailed is returned by MessageDecoder.Decode when no combination of
+// block candidates passed the message check.
+var ErrCheckFailed = errors.New("golay: no candidate combination passed the message check")
+
+// MessageDecoder performs list decoding of messages that span several Golay(23,12) blocks
+// and carry their own integrity check, such as a CRC.
+//
+// For each 23-bit block it keeps a short list of candidate codewords from ListDecode.
+// It then tries combinations of candidates in order of increasing total Hamming distance,
+// starting with the nearest codeword of every block (the result of Decoder),
+// until the caller-supplied check accepts the decoded message.
+// A block with 4 or more errors, which Decoder miscorrects, can thereby still be recovered
+// when its transmitted codeword is on its candidate list.
+type MessageDecoder struct {
+	check       func(msg []byte) bool
+	radius      int
+	listSize    int
+	maxAttempts int
+	messageBits int
+	attempts    int
+	distance    int
+}
+
+// NewMessageDecoder creates a new MessageDecoder that accepts a decoded message when check returns true.
+// check receives the MSB-aligned decoded data bytes; bits beyond the message length
+// (see SetMessageBits) are zero. check must not retain the slice.
+func NewMessageDecoder(check func(msg []byte) bool) *MessageDecoder {
+	if check == nil {
+		panic("check must not be nil")
+	}
+	return &MessageDecoder{
+		check:       check,
+		radius:      5,
+		listSize:    4,
+		maxAttempts: 4096,
+	}
+}
+
+// SetRadius sets the Hamming radius of the per-block candidate lists. The default is 5.
+// Values below 3 are raised to 3, the radius within which every word has a candidate.
+func (d *MessageDecoder) SetRadius(radius int) {
+	d.radius = max(radius, 3)
+}
+
+// SetListSize sets the maximum number of candidates kept per block. The default is 4.
+// Values below 1 are raised to 1, which reduces the search to a single Decoder attempt.
+func (d *MessageDecoder) SetListSize(n int) {
+	d.listSize = max(n, 1)
+}
+
+// SetMaxAttempts sets the maximum number of combinations passed to the check. The default is 4096.
+// Values below 1 are raised to 1.
+func (d *MessageDecoder) SetMaxAttempts(n int) {
+	d.maxAttempts = max(n, 1)
+}
+
+// SetMessageBits sets the length of the message in bits. The decoded data is truncated
+// to this length before it is passed to the check, which drops the padding of the last block.
+// If bits is 0, all decoded bits are passed.
+func (d *MessageDecoder) SetMessageBits(bits int) {
+	d.messageBits = max(bits, 0)
+}
+
+// Decode decodes the encoded message in data and returns the first decoded message
+// accepted by the check, truncated to the message length.
+// data must be a slice of BinaryValue type ([]uint8, []uint16, []uint32, []uint64, or []uint).
+// The bits parameter specifies how many bits in the data are valid; any bits that
+// do not complete a 23-bit block are ignored. If bits is 0, all bits are considered valid.
+// If no combination passes the check within the attempt limit, Decode returns
+// the message of the nearest codewords together with ErrCheckFailed.
+func (d *MessageDecoder) Decode(data any, bits int) ([]byte, error) {
+	if data == nil {
+		return nil, ErrNilData
+	}
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice {
+		return nil, ErrNotSlice
+	}
+	reader, err := newSliceReader(rv)
+	if err != nil {
+		return nil, err
+	}
+	if bits > 0 {
+		reader.SetBits(bits)
+	}
+
+	lists := make([][]Candidate, reader.Bits()/23)
+	for i := range lists {
+		list := ListDecode(reader.Read32R(23, i), d.radius)
+		lists[i] = list[:min(len(list), d.listSize)]
+	}
+
+	d.attempts, d.distance = 0, 0
+	var first []byte
+	search := newCombinationSearch(lists)
+	for d.attempts < d.maxAttempts {
+		idx, distance, ok := search.next()
+		if !ok {
+			break
+		}
+		msg := d.message(lists, idx)
+		if first == nil {
+			first = msg
+		}
+		d.attempts++
+		if d.check(msg) {
+			d.distance = distance
+			return msg, nil
+		}
+	}
+	return first, ErrCheckFailed
+}
+
+// Attempts returns the number of combinations passed to the check during the last Decode call.
+func (d *MessageDecoder) Attempts() int {
+	return d.attempts
+}
+
+// Distance returns the total Hamming distance between the received blocks and the
+// codewords of the message accepted by the last Decode call.
+func (d *MessageDecoder) Distance() int {
+	return d.distance
+}
+
+// message writes the data of the idx-th candidate of each block and truncates it to the message length.
+func (d *MessageDecoder) message(lists [][]Candidate, idx []int) []byte {
+	writer := bitstream.NewBitWriter[uint8](0, 0)
+	for i, list := range lists {
+		// right 12 bits are data
+		writer.Write16(4, 12, list[idx[i]].Data)
+	}
+	msg := writer.Data()
+	n := writer.Bits()
+	if d.messageBits > 0 && d.messageBits < n {
+		n = d.messageBits
+	}
+	msg = msg[:(n+7)/8]
+	if n%8 != 0 {
+		msg[len(msg)-1] &= 0xFF << (8 - n%8)
+	}
+	return msg
+}
+
+// combinationSearch enumerates combinations of one candidate per block
+// in order of increasing total distance. Each combination is generated exactly once:
+// a combination is derived from its parent by advancing one block at or after
+// the block the parent advanced.
+type combinationSearch struct {
+	lists [][]Candidate
+	queue combinationQueue
+	seq   int
+}
+
+type combination struct {
+	idx      []int
+	pos      int // first block that may be advanced
+	distance int
+	seq      int // insertion order, to break ties deterministically
+}
+
+func newCombinationSearch(lists [][]Candidate) *combinationSearch {
+	s := &combinationSearch{lists: lists}
+	root := combination{idx: make([]int, len(lists))}
+	for _, list := range lists {
+		root.distance += list[0].Distance
+	}
+	heap.Push(&s.queue, root)
+	return s
+}
+
+// next returns the candidate indices and total distance of the next combination,
+// or ok false if all combinations have been returned.
+func (s *combinationSearch) next() (idx []int, distance int, ok bool) {
+	if s.queue.Len() == 0 {
+		return nil, 0, false
+	}
+	c := heap.Pop(&s.queue).(combination)
+	for j := c.pos; j < len(s.lists); j++ {
+		k := c.idx[j]
+		if k+1 >= len(s.lists[j]) {
+			continue
+		}
+		child := combination{idx: append([]int(nil), c.idx...), pos: j}
+		child.idx[j]++
+		child.distance = c.distance - s.lists[j][k].Distance + s.lists[j][k+1].Distance
+		s.seq++
+		child.seq = s.seq
+		heap.Push(&s.queue, child)
+	}
+	return c.idx, c.distance, true
+}
+
+// combinationQueue is a min-heap of combinations ordered by distance.
+type combinationQueue []combination
+
+func (q combinationQueue) Len() int { return len(q) }
+func (q combinationQueue) Less(i, j int) bool {
+	if q[i].distance != q[j].distance {
+		return q[i].distance < q[j].distance
+	}
+	return q[i].seq < q[j].seq
+}
+func (q combinationQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *combinationQueue) Push(x any)   { *q = append(*q, x.(combination)) }
+func (q *combinationQueue) Pop() any {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
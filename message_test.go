@@ -0,0 +1,115 @@
+package golay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"testing"
+)
+
+func TestMessageDecoder(t *testing.T) {
+	// 8 payload bytes and a CRC-32 fill exactly 8 blocks
+	payload := []byte("sensor42")
+	msg := binary.BigEndian.AppendUint32(append([]byte(nil), payload...), crc32.ChecksumIEEE(payload))
+	check := func(m []byte) bool {
+		return len(m) == 12 && crc32.ChecksumIEEE(m[:8]) == binary.BigEndian.Uint32(m[8:])
+	}
+	var encoded []uint8
+	enc := NewEncoder(&encoded)
+	_ = enc.Encode(msg, 0)
+	flip := func(data []uint8, positions ...int) []uint8 {
+		data = append([]uint8(nil), data...)
+		for _, p := range positions {
+			data[p/8] ^= 0x80 >> (p % 8)
+		}
+		return data
+	}
+
+	t.Run("Clean", func(t *testing.T) {
+		dec := NewMessageDecoder(check)
+		got, err := dec.Decode(encoded, enc.Bits())
+		if err != nil || !bytes.Equal(got, msg) {
+			t.Fatalf("MessageDecoder.Decode failed: got (%x, %v), want (%x, nil)", got, err, msg)
+		}
+		if dec.Attempts() != 1 || dec.Distance() != 0 {
+			t.Errorf("MessageDecoder failed: got %d attempts at distance %d, want 1 at 0", dec.Attempts(), dec.Distance())
+		}
+	})
+	t.Run("FourErrors", func(t *testing.T) {
+		// 4 errors in block 2 and 1 error in block 5
+		received := flip(encoded, 46+0, 46+5, 46+13, 46+20, 115+7)
+		var plain []uint8
+		_ = NewDecoder(received, enc.Bits()).Decode(&plain)
+		if check(plain[:12]) {
+			t.Fatal("Decoder unexpectedly recovered the message")
+		}
+		dec := NewMessageDecoder(check)
+		got, err := dec.Decode(received, enc.Bits())
+		if err != nil || !bytes.Equal(got, msg) {
+			t.Fatalf("MessageDecoder.Decode failed: got (%x, %v), want (%x, nil)", got, err, msg)
+		}
+		if dec.Attempts() < 2 || dec.Distance() != 5 {
+			t.Errorf("MessageDecoder failed: got %d attempts at distance %d, want >1 at 5", dec.Attempts(), dec.Distance())
+		}
+	})
+	t.Run("CheckFailed", func(t *testing.T) {
+		dec := NewMessageDecoder(func([]byte) bool { return false })
+		dec.SetMaxAttempts(3)
+		// 3 errors in block 0 leave further candidates at distance 4 and 5
+		received := flip(encoded, 0, 1, 2)
+		got, err := dec.Decode(received, enc.Bits())
+		if !errors.Is(err, ErrCheckFailed) {
+			t.Fatalf("MessageDecoder.Decode failed: got error %v, want %v", err, ErrCheckFailed)
+		}
+		if !bytes.Equal(got, msg) || dec.Attempts() != 3 {
+			t.Errorf("MessageDecoder.Decode failed: got (%x, %d attempts), want (%x, 3 attempts)", got, dec.Attempts(), msg)
+		}
+		dec.SetListSize(1)
+		dec.SetMaxAttempts(100)
+		if _, err := dec.Decode(received, enc.Bits()); !errors.Is(err, ErrCheckFailed) || dec.Attempts() != 1 {
+			t.Errorf("MessageDecoder.Decode failed: got (%v, %d attempts), want (%v, 1 attempt)", err, dec.Attempts(), ErrCheckFailed)
+		}
+	})
+	t.Run("MessageBits", func(t *testing.T) {
+		// 20 message bits in 2 blocks, the padding is masked
+		var enc2 []uint8
+		e := NewEncoder(&enc2)
+		_ = e.Encode([]uint8{0xAB, 0xCD, 0xEF}, 20)
+		var seen []byte
+		dec := NewMessageDecoder(func(m []byte) bool { seen = m; return true })
+		dec.SetMessageBits(20)
+		got, _ := dec.Decode(enc2, e.Bits())
+		if want := []byte{0xAB, 0xCD, 0xE0}; !bytes.Equal(got, want) || !bytes.Equal(seen, want) {
+			t.Errorf("MessageDecoder.Decode failed: got %x, want %x", got, want)
+		}
+	})
+	t.Run("Order", func(t *testing.T) {
+		lists := [][]Candidate{
+			{{Distance: 0}, {Distance: 4}, {Distance: 5}},
+			{{Distance: 1}, {Distance: 3}},
+			{{Distance: 2}, {Distance: 4}, {Distance: 4}},
+		}
+		s := newCombinationSearch(lists)
+		seen := make(map[[3]int]bool)
+		prev := -1
+		for {
+			idx, dist, ok := s.next()
+			if !ok {
+				break
+			}
+			key := [3]int{idx[0], idx[1], idx[2]}
+			if seen[key] {
+				t.Fatalf("combination %v returned twice", key)
+			}
+			seen[key] = true
+			if dist < prev {
+				t.Fatalf("combination %v at distance %d after distance %d", key, dist, prev)
+			}
+			prev = dist
+		}
+		if len(seen) != 3*2*3 {
+			t.Errorf("combinationSearch failed: got %d combinations, want %d", len(seen), 18)
+		}
+	})
+}
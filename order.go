@@ -0,0 +1,51 @@
+package golay
+
+import "math/bits"
+
+// BitOrder is the order in which the bits of each slice element are transmitted.
+type BitOrder int
+
+const (
+	// MSBFirst transmits the most significant bit of each element first.
+	MSBFirst BitOrder = iota
+	// LSBFirst transmits the least significant bit of each element first.
+	LSBFirst
+)
+
+// String returns the name of the bit order.
+func (o BitOrder) String() string {
+	if o == LSBFirst {
+		return "LSBFirst"
+	}
+	return "MSBFirst"
+}
+
+// reverseElements returns a copy of data with the bits of every element reversed,
+// which converts between MSB-first and LSB-first transmission order.
+func reverseElements[T BinaryValue](data []T) []T {
+	size := bitSize[T]()
+	out := make([]T, len(data))
+	for i, v := range data {
+		out[i] = T(bits.Reverse64(uint64(v)) >> (64 - size))
+	}
+	return out
+}
+
+// reverseAny returns a copy of data, a slice of BinaryValue type,
+// with the bits of every element reversed.
+func reverseAny(data any) any {
+	switch d := data.(type) {
+	case []uint64:
+		return reverseElements(d)
+	case []uint32:
+		return reverseElements(d)
+	case []uint16:
+		return reverseElements(d)
+	case []uint8:
+		return reverseElements(d)
+	case []uint:
+		return reverseElements(d)
+	default:
+		return data
+	}
+}
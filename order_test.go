@@ -0,0 +1,101 @@
+package golay
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBitOrder(t *testing.T) {
+	plain := []uint8{0x48, 0x65, 0x6C, 0x6C, 0x6F, 0x21}
+	var msb []uint8
+	enc := NewEncoder(&msb)
+	_ = enc.Encode(plain, 0)
+
+	t.Run("Encoder", func(t *testing.T) {
+		for _, tc := range []struct {
+			in, out BitOrder
+		}{{MSBFirst, LSBFirst}, {LSBFirst, MSBFirst}, {LSBFirst, LSBFirst}} {
+			input, want := plain, msb
+			if tc.in == LSBFirst {
+				input = reverseElements(plain)
+			}
+			if tc.out == LSBFirst {
+				want = reverseElements(msb)
+			}
+			var got []uint8
+			e := NewEncoder(&got)
+			e.SetBitOrder(tc.in, tc.out)
+			// two calls append to the same output
+			_ = e.Encode(input[:3], 0)
+			_ = e.Encode(input[3:], 0)
+			if !slices.Equal(got, want) || e.Bits() != enc.Bits() {
+				t.Errorf("Encoder(%v, %v) failed: got %#x, want %#x", tc.in, tc.out, got, want)
+			}
+		}
+	})
+	t.Run("Incremental", func(t *testing.T) {
+		// one block per call leaves a partial element after most calls
+		var want, got []uint16
+		e, lsb := NewEncoder(&want), NewEncoder(&got)
+		lsb.SetBitOrder(MSBFirst, LSBFirst)
+		for i := 0; i < len(plain); i += 3 {
+			_ = e.Encode(plain[i:i+3], 12)
+			_ = lsb.Encode(plain[i:i+3], 12)
+			if !slices.Equal(got, reverseElements(want)) {
+				t.Fatalf("Encode %d failed: got %#x, want %#x", i/3, got, reverseElements(want))
+			}
+		}
+	})
+	t.Run("Decoder", func(t *testing.T) {
+		for _, tc := range []struct {
+			in, out BitOrder
+		}{{MSBFirst, MSBFirst}, {LSBFirst, MSBFirst}, {MSBFirst, LSBFirst}, {LSBFirst, LSBFirst}} {
+			input, want := msb, plain
+			if tc.in == LSBFirst {
+				input = reverseElements(msb)
+			}
+			if tc.out == LSBFirst {
+				want = reverseElements(plain)
+			}
+			var got []uint8
+			d := NewDecoder(input, enc.Bits())
+			d.SetBitOrder(tc.in, tc.out)
+			if err := d.Decode(&got); err != nil || !slices.Equal(got, want) {
+				t.Errorf("Decoder(%v, %v) failed: got (%#x, %v), want %#x", tc.in, tc.out, got, err, want)
+			}
+		}
+	})
+	t.Run("Reorder", func(t *testing.T) {
+		var got []uint8
+		d := NewDecoder(reverseElements(msb), enc.Bits())
+		d.SetBitOrder(MSBFirst, MSBFirst)
+		d.SetBitOrder(LSBFirst, LSBFirst)
+		d.SetBitOrder(LSBFirst, MSBFirst)
+		if err := d.Decode(&got); err != nil || !slices.Equal(got, plain) {
+			t.Errorf("Decode failed: got (%#x, %v), want %#x", got, err, plain)
+		}
+		d.SetBitOrder(MSBFirst, MSBFirst)
+		d.SetBitOrder(LSBFirst, MSBFirst)
+		if err := d.Decode(&got); err != nil || !slices.Equal(got, plain) {
+			t.Errorf("Decode after SetBitOrder failed: got (%#x, %v), want %#x", got, err, plain)
+		}
+	})
+	t.Run("CRC", func(t *testing.T) {
+		// an LSB-first frame is verified by an LSB-first Decoder
+		var encoded []uint16
+		e := NewEncoder(&encoded)
+		e.SetBitOrder(LSBFirst, LSBFirst)
+		e.SetCRC(CRC16CCITT)
+		_ = e.Encode(plain, 0)
+
+		var got []uint8
+		d := NewDecoder(encoded, e.Bits())
+		d.SetBitOrder(LSBFirst, LSBFirst)
+		d.SetCRC(CRC16CCITT)
+		// the frame pads the data to whole blocks before the check value
+		want := append(slices.Clone(plain), 0)
+		if err := d.Decode(&got); err != nil || !slices.Equal(got, want) {
+			t.Errorf("Decoder with CRC failed: got (%#x, %v), want %#x", got, err, want)
+		}
+	})
+}
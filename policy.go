@@ -0,0 +1,85 @@
+package golay
+
+import "math/bits"
+
+// Policy selects how many errors a decoder corrects and how many it only detects.
+// The Golay(23,12) code has a minimum distance of 7, so any split with
+// correctable + detectable = 6 is possible: the fewer errors are corrected,
+// the fewer received words with 4 or more errors are silently miscorrected.
+type Policy int
+
+const (
+	// CorrectAll corrects up to 3 errors. It is the default policy and never rejects a word.
+	CorrectAll Policy = iota
+	// Correct2Detect4 corrects up to 2 errors and detects 3 or 4 errors.
+	Correct2Detect4
+	// Correct1Detect5 corrects 1 error and detects 2 to 5 errors.
+	Correct1Detect5
+	// DetectOnly corrects nothing and detects up to 6 errors:
+	// every word with a non-zero syndrome is rejected.
+	DetectOnly
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case CorrectAll:
+		return "CorrectAll"
+	case Correct2Detect4:
+		return "Correct2Detect4"
+	case Correct1Detect5:
+		return "Correct1Detect5"
+	case DetectOnly:
+		return "DetectOnly"
+	default:
+		return "Policy(unknown)"
+	}
+}
+
+// Correctable returns the largest number of errors corrected under the policy.
+// Unknown policies behave like CorrectAll.
+func (p Policy) Correctable() int {
+	if p < CorrectAll || p > DetectOnly {
+		return 3
+	}
+	return 3 - int(p)
+}
+
+// accepts reports whether a correction of weight errors is allowed under the policy.
+// CorrectAll and unknown policies accept any weight, including the corrections of
+// more than 3 errors made with known bits.
+func (p Policy) accepts(weight int) bool {
+	return p.Correctable() >= 3 || weight <= p.Correctable()
+}
+
+// Detectable returns the largest number of errors that are guaranteed to be
+// detected rather than miscorrected under the policy.
+func (p Policy) Detectable() int {
+	return 6 - p.Correctable()
+}
+
+// DecodePolicy decodes a 23-bit Golay(23,12) codeword into 12-bit data under the given policy.
+// Input values exceeding 23 bits are masked to 23 bits.
+// ok is false if the word needs more corrections than the policy allows;
+// data then holds the data bits as received.
+func DecodePolicy(codeword uint32, policy Policy) (data uint16, ok bool) {
+	return decodeBlock(0, codeword, nil, policy)
+}
+
+// decodeBlock decodes the n-th codeword of a stream under policy and reports corrections to hook.
+// Rejected words are not reported to hook and return their received data bits.
+func decodeBlock(n int, codeword uint32, hook CorrectionHook, policy Policy) (uint16, bool) {
+	codeword &= 0x7FFFFF
+	syndrome := syndromeOf(codeword)
+	if syndrome == 0 {
+		return uint16(codeword >> 11), true
+	}
+	pattern := corrections[syndrome]
+	if !policy.accepts(bits.OnesCount32(pattern)) {
+		return uint16(codeword >> 11), false
+	}
+	if hook != nil {
+		hook(Correction{Block: n, Syndrome: syndrome, Pattern: pattern})
+	}
+	return uint16((codeword ^ pattern) >> 11), true
+}
@@ -0,0 +1,102 @@
+package golay
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestPolicy(t *testing.T) {
+	t.Run("DecodePolicy", func(t *testing.T) {
+		const data = 0xABC
+		c := EncodeWord(data)
+		for _, p := range []Policy{CorrectAll, Correct2Detect4, Correct1Detect5, DetectOnly} {
+			// all error patterns of weight 0 to 6
+			for e := range uint32(1 << 23) {
+				w := bits.OnesCount32(e)
+				if w > 6 {
+					continue
+				}
+				got, ok := DecodePolicy(c^e, p)
+				switch {
+				case w <= p.Correctable():
+					if !ok || got != data {
+						t.Fatalf("%v: DecodePolicy(%06x) failed: got (%03x, %v), want (%03x, true)", p, c^e, got, ok, data)
+					}
+				case w <= p.Detectable():
+					if want := uint16((c ^ e) >> 11); ok || got != want {
+						t.Fatalf("%v: DecodePolicy(%06x) failed: got (%03x, %v), want (%03x, false)", p, c^e, got, ok, want)
+					}
+				}
+			}
+		}
+	})
+	t.Run("String", func(t *testing.T) {
+		tests := map[Policy]string{
+			CorrectAll: "CorrectAll", Correct2Detect4: "Correct2Detect4",
+			Correct1Detect5: "Correct1Detect5", DetectOnly: "DetectOnly", Policy(9): "Policy(unknown)",
+		}
+		for p, want := range tests {
+			if got := p.String(); got != want {
+				t.Errorf("Policy.String() failed: got %q, want %q", got, want)
+			}
+		}
+		if got := Policy(9).Correctable(); got != 3 {
+			t.Errorf("Policy.Correctable() failed: got %d, want %d", got, 3)
+		}
+	})
+	t.Run("Decoder", func(t *testing.T) {
+		// 4 blocks with 0, 1, 2 and 3 errors
+		var encoded []uint32
+		enc := NewEncoder(&encoded)
+		_ = enc.Encode([]uint16{0x1234, 0x5678, 0x9ABC}, 48)
+		errs := []int{23 + 5, 46 + 1, 46 + 20, 69 + 3, 69 + 13, 69 + 22}
+		for _, i := range errs {
+			encoded[i/32] ^= 1 << (31 - i%32)
+		}
+		tests := []struct {
+			policy   Policy
+			rejected []int
+		}{
+			{CorrectAll, nil},
+			{Correct2Detect4, []int{3}},
+			{Correct1Detect5, []int{2, 3}},
+			{DetectOnly, []int{1, 2, 3}},
+		}
+		for _, tt := range tests {
+			var out []uint16
+			dec := NewDecoder(encoded, enc.Bits())
+			dec.SetPolicy(tt.policy)
+			var corrected []int
+			dec.SetCorrectionHook(func(c Correction) { corrected = append(corrected, c.Block) })
+			_ = dec.Decode(&out)
+			if !slices.Equal(dec.Rejected(), tt.rejected) {
+				t.Errorf("%v: Decoder.Rejected() failed: got %v, want %v", tt.policy, dec.Rejected(), tt.rejected)
+			}
+			for _, b := range corrected {
+				if slices.Contains(tt.rejected, b) {
+					t.Errorf("%v: rejected block %d reported as corrected", tt.policy, b)
+				}
+			}
+			var chunked []uint16
+			chunks := NewChunkDecoder(&chunked)
+			chunks.SetPolicy(tt.policy)
+			_ = chunks.Decode(encoded[:1], 0)
+			_ = chunks.Decode(encoded[1:], enc.Bits()-32)
+			if !slices.Equal(chunked, out) {
+				t.Errorf("%v: ChunkDecoder.Decode failed: got %#x, want %#x", tt.policy, chunked, out)
+			}
+			if !slices.Equal(chunks.Rejected(), tt.rejected) {
+				t.Errorf("%v: ChunkDecoder.Rejected() failed: got %v, want %v", tt.policy, chunks.Rejected(), tt.rejected)
+			}
+		}
+		// a rejected block keeps the received data bits
+		var out []uint16
+		dec := NewDecoder(encoded, enc.Bits())
+		dec.SetPolicy(DetectOnly)
+		_ = dec.Decode(&out)
+		if want := uint16(0x5678) ^ 1<<14 ^ 1<<6; out[1] != want {
+			t.Errorf("Decoder.Decode failed: got %#x, want %#x", out[1], want)
+		}
+	})
+}
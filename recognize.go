@@ -0,0 +1,263 @@
+package golay
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// Generator polynomials of the Golay(23,12) code, including the x^11 term, MSB first.
+const (
+	// GeneratorPoly is x^11+x^9+x^7+x^6+x^5+x+1, the polynomial of EncodeWord.
+	GeneratorPoly = 0xAE3
+	// ReciprocalPoly is x^11+x^10+x^6+x^5+x^4+x^2+1. Its codewords are the bit-reversed
+	// codewords of GeneratorPoly.
+	ReciprocalPoly = 0xC75
+)
+
+// ErrUnrecognized is returned by Recognize when no configuration matches the capture.
+var ErrUnrecognized = errors.New("golay: capture does not match a Golay configuration")
+
+// Layout is the arrangement of data and parity bits within a codeword.
+type Layout int
+
+const (
+	// DataFirst places the 12 data bits before the 11 parity bits, like EncodeWord.
+	DataFirst Layout = iota
+	// ParityFirst places the 11 parity bits before the 12 data bits.
+	ParityFirst
+)
+
+// String returns the name of the layout.
+func (l Layout) String() string {
+	if l == ParityFirst {
+		return "ParityFirst"
+	}
+	return "DataFirst"
+}
+
+// Recognition is a configuration of a Golay-coded capture and how well the capture matches it.
+type Recognition struct {
+	// Length is the codeword length: 23, or 24 for the extended code with the overall
+	// parity bit last, like EncodeExtended.
+	Length int
+	// Offset is the bit offset of the first codeword in the capture.
+	Offset int
+	// Order is the bit order of the capture elements.
+	Order BitOrder
+	// Layout is the arrangement of data and parity bits.
+	Layout Layout
+	// Poly is the generator polynomial, GeneratorPoly or ReciprocalPoly.
+	Poly uint16
+	// Score is the mean correction weight per codeword:
+	// 0 if every codeword is valid, about 2.84 for random bits (3.3 for 24-bit words).
+	Score float64
+	// Rank is the GF(2) rank of the analysed codewords. It is 12 for error-free
+	// codewords of enough distinct data words and approaches the codeword length
+	// for misaligned or noisy blocks.
+	Rank int
+	// Blocks is the number of analysed codewords.
+	Blocks int
+}
+
+// String returns a one-line summary of the configuration.
+func (r Recognition) String() string {
+	return fmt.Sprintf("Golay(%d,12) offset=%d order=%v layout=%v poly=%#x score=%.3f rank=%d blocks=%d",
+		r.Length, r.Offset, r.Order, r.Layout, r.Poly, r.Score, r.Rank, r.Blocks)
+}
+
+// recognizeBlocks is the maximum number of codewords analysed per hypothesis.
+const recognizeBlocks = 64
+
+// Recognize infers the configuration of a capture of unknown Golay-coded data.
+// data is MSB-aligned and bits specifies how many bits are valid (0 means all).
+//
+// Every combination of codeword length, offset, bit order and generator polynomial
+// is scored by the mean correction weight of up to 64 codewords, and the GF(2) rank of
+// the codewords is computed as supporting evidence. Since the code is cyclic, both layouts
+// produce valid codewords; the layout is inferred from the data bits, which are usually
+// more biased than the parity bits, and is DataFirst if the data looks uniformly random.
+//
+// The hypotheses are returned in order of likelihood: by score, then rank, then preferring
+// MSBFirst, length 23, GeneratorPoly and the smallest offset. ErrUnrecognized is returned
+// with the hypotheses if the best one scores above 1, or has a rank below 12 because the
+// capture holds too few distinct codewords to tell the configurations apart. It is returned
+// without hypotheses if the capture holds fewer than 16 codewords.
+func Recognize[T BinaryValue](data []T, bits int) ([]Recognition, error) {
+	n := validBits(data, bits)
+	if n < 16*24+23 {
+		return nil, ErrUnrecognized
+	}
+	var hyps []Recognition
+	for _, order := range []BitOrder{MSBFirst, LSBFirst} {
+		src := data
+		if order == LSBFirst {
+			src = reverseElements(data)
+		}
+		for _, length := range []int{23, 24} {
+			for off := range length {
+				blocks := readBlocks(src, n, off, length)
+				rank := rankGF2(blocks)
+				for _, poly := range []uint16{GeneratorPoly, ReciprocalPoly} {
+					hyps = append(hyps, Recognition{
+						Length: length,
+						Offset: off,
+						Order:  order,
+						Layout: inferLayout(blocks, length),
+						Poly:   poly,
+						Score:  recognitionScore(blocks, length, poly),
+						Rank:   rank,
+						Blocks: len(blocks),
+					})
+				}
+			}
+		}
+	}
+	// the hypotheses were generated in order of preference
+	slices.SortStableFunc(hyps, func(a, b Recognition) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), cmp.Compare(a.Rank, b.Rank))
+	})
+	if hyps[0].Score > 1 || hyps[0].Rank < 12 {
+		return hyps, ErrUnrecognized
+	}
+	return hyps, nil
+}
+
+// Realign converts a capture in the recognized configuration into MSB-first DataFirst
+// Golay(23,12) codewords starting at bit 0, as produced by Encoder, and returns them
+// with the number of valid bits for NewDecoder. The overall parity bit of 24-bit codewords
+// is dropped. Bits after the last whole codeword are discarded.
+// It returns an error for ReciprocalPoly, whose codewords cannot be mapped to EncodeWord
+// codewords with the same data bits by reordering bits; use Recognition.DecodeBlock instead.
+func Realign[T BinaryValue](data []T, bits int, r Recognition) ([]uint8, int, error) {
+	if r.Poly != GeneratorPoly {
+		return nil, 0, fmt.Errorf("golay: cannot realign codewords of polynomial %#x", r.Poly)
+	}
+	if r.Length != 23 && r.Length != 24 {
+		return nil, 0, fmt.Errorf("golay: unsupported codeword length %d", r.Length)
+	}
+	n := validBits(data, bits)
+	if r.Order == LSBFirst {
+		data = reverseElements(data)
+	}
+	count := max(n-r.Offset, 0) / r.Length
+	out := make([]uint8, (count*23+7)/8)
+	for i := range count {
+		cw := getBits(data, r.Offset+i*r.Length, r.Length)
+		putBits(out, i*23, 23, r.dataFirst(cw))
+	}
+	return out, count * 23, nil
+}
+
+// DecodeBlock decodes one codeword of the recognized configuration, given as its
+// Length bits read in the recognized bit order, and returns the 12 data bits.
+// Up to 3 bit errors are corrected; the overall parity bit of 24-bit codewords is ignored.
+func (r Recognition) DecodeBlock(block uint32) uint16 {
+	cw := r.dataFirst(block)
+	if r.Poly == ReciprocalPoly {
+		// the reversed codeword is a codeword of GeneratorPoly
+		rev := reverse23(cw)
+		rev ^= corrections[syndromeOf(rev)]
+		return uint16(reverse23(rev) >> 11)
+	}
+	return Decode(cw)
+}
+
+// dataFirst returns the 23-bit codeword of a block in DataFirst layout.
+func (r Recognition) dataFirst(block uint32) uint32 {
+	cw := block
+	if r.Length == 24 {
+		cw >>= 1
+	}
+	cw &= 0x7FFFFF
+	if r.Layout == ParityFirst {
+		// [parity(11) | data(12)] is a cyclic shift of [data | parity]
+		cw = (cw&0xFFF)<<11 | cw>>12
+	}
+	return cw
+}
+
+// reverse23 reverses the order of the low 23 bits.
+func reverse23(cw uint32) uint32 {
+	return bits.Reverse32(cw) >> 9
+}
+
+// readBlocks reads up to recognizeBlocks blocks of length bits starting at bit offset off.
+func readBlocks[T BinaryValue](data []T, n, off, length int) []uint32 {
+	var blocks []uint32
+	for p := off; p+length <= n && len(blocks) < recognizeBlocks; p += length {
+		blocks = append(blocks, getBits(data, p, length))
+	}
+	return blocks
+}
+
+// recognitionScore returns the mean correction weight of the blocks for a generator polynomial.
+// A 24-bit block also counts a wrong overall parity after correction.
+func recognitionScore(blocks []uint32, length int, poly uint16) float64 {
+	sum := 0
+	for _, b := range blocks {
+		cw := b
+		if length == 24 {
+			cw >>= 1
+		}
+		cw &= 0x7FFFFF
+		if poly == ReciprocalPoly {
+			cw = reverse23(cw)
+		}
+		pattern := corrections[syndromeOf(cw)]
+		sum += bits.OnesCount32(pattern)
+		if length == 24 && bits.OnesCount32(b^pattern<<1)%2 != 0 {
+			sum++
+		}
+	}
+	return float64(sum) / float64(len(blocks))
+}
+
+// inferLayout compares the mean bias of the first and last 11 bits of the 23-bit part
+// of the blocks; the data bits are expected to be the more biased ones.
+func inferLayout(blocks []uint32, length int) Layout {
+	var ones [23]int
+	for _, b := range blocks {
+		if length == 24 {
+			b >>= 1
+		}
+		for i := range 23 {
+			ones[i] += int(b >> i & 1)
+		}
+	}
+	bias := func(lo, hi int) float64 {
+		sum := 0.0
+		for i := lo; i < hi; i++ {
+			sum += math.Abs(float64(ones[i])/float64(len(blocks)) - 0.5)
+		}
+		return sum
+	}
+	// bits 22..12 are parity for ParityFirst, bits 10..0 are parity for DataFirst
+	if bias(0, 11) > bias(12, 23) {
+		return ParityFirst
+	}
+	return DataFirst
+}
+
+// rankGF2 returns the rank of the blocks as vectors over GF(2).
+func rankGF2(blocks []uint32) int {
+	var basis [32]uint32 // basis[i] has its highest set bit at i
+	rank := 0
+	for _, b := range blocks {
+		for i := 31; i >= 0 && b != 0; i-- {
+			if b>>i&1 == 0 {
+				continue
+			}
+			if basis[i] == 0 {
+				basis[i] = b
+				rank++
+				break
+			}
+			b ^= basis[i]
+		}
+	}
+	return rank
+}
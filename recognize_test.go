@@ -0,0 +1,117 @@
+package golay
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRecognize(t *testing.T) {
+	text := []byte("Golay codes are perfect codes: every 23-bit word lies within distance 3 of exactly one codeword. ")
+	text = append(text, text...)
+	text = text[:len(text)/3*3]
+	// words returns the 12-bit blocks of text
+	var words []uint16
+	for pos := 0; pos+12 <= len(text)*8; pos += 12 {
+		words = append(words, uint16(getBits(text, pos, 12)))
+	}
+	// capture packs the codewords of length bits after skip junk bits
+	capture := func(skip, length int, codeword func(uint16) uint32) ([]uint8, int) {
+		n := skip + len(words)*length
+		buf := make([]uint8, (n+7)/8)
+		putBits(buf, 0, skip, 0x5A5A5&(1<<skip-1))
+		for i, w := range words {
+			putBits(buf, skip+i*length, length, codeword(w))
+		}
+		return buf, n
+	}
+	reverse12 := func(d uint16) uint16 { return uint16(reverse23(uint32(d)) >> 11) }
+	cases := []struct {
+		name     string
+		length   int
+		order    BitOrder
+		layout   Layout
+		poly     uint16
+		codeword func(uint16) uint32
+	}{
+		{"Native", 23, MSBFirst, DataFirst, GeneratorPoly, EncodeWord},
+		{"LSBFirst", 23, LSBFirst, DataFirst, GeneratorPoly, EncodeWord},
+		{"Extended", 24, MSBFirst, DataFirst, GeneratorPoly, EncodeExtended},
+		{"ParityFirst", 23, MSBFirst, ParityFirst, GeneratorPoly, func(d uint16) uint32 {
+			c := EncodeWord(d)
+			return (c&0x7FF)<<12 | c>>11
+		}},
+		{"Reciprocal", 23, MSBFirst, DataFirst, ReciprocalPoly, func(d uint16) uint32 {
+			// reversing [parity | reversed data] gives [data | reciprocal parity]
+			c := EncodeWord(reverse12(d))
+			return reverse23((c&0x7FF)<<12 | c>>11)
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf, n := capture(13, tc.length, tc.codeword)
+			if tc.order == LSBFirst {
+				buf = reverseElements(buf)
+			}
+			// a few bit errors
+			buf[20] ^= 0x81
+			buf[50] ^= 0x04
+			hyps, err := Recognize(buf, n)
+			if err != nil {
+				t.Fatalf("Recognize failed: %v", err)
+			}
+			got := hyps[0]
+			if got.Length != tc.length || got.Offset != 13 || got.Order != tc.order ||
+				got.Layout != tc.layout || got.Poly != tc.poly {
+				t.Fatalf("Recognize failed: got %v, want length %d, offset 13, %v, %v, poly %#x",
+					got, tc.length, tc.order, tc.layout, tc.poly)
+			}
+			if got.Score >= 0.2 || got.Rank < 12 || hyps[1].Score <= got.Score {
+				t.Errorf("Recognize failed: got %v, second %v", got, hyps[1])
+			}
+
+			// the data is recovered through Realign or DecodeBlock
+			var plain []uint8
+			if tc.poly == GeneratorPoly {
+				aligned, bits, err := Realign(buf, n, got)
+				if err != nil {
+					t.Fatalf("Realign failed: %v", err)
+				}
+				if err := NewDecoder(aligned, bits).Decode(&plain); err != nil {
+					t.Fatalf("Decode failed: %v", err)
+				}
+			} else {
+				if _, _, err := Realign(buf, n, got); err == nil {
+					t.Fatal("Realign of ReciprocalPoly succeeded, want error")
+				}
+				out := make([]uint8, len(words)*12/8)
+				for i := range words {
+					block := getBits(buf, 13+i*23, 23)
+					putBits(out, i*12, 12, uint32(got.DecodeBlock(block)))
+				}
+				plain = out
+			}
+			if !slices.Equal(plain, text) {
+				t.Errorf("decoded data failed: got %q, want %q", plain, text)
+			}
+		})
+	}
+	t.Run("Unrecognized", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		random := make([]uint8, 300)
+		for i := range random {
+			random[i] = uint8(rng.Intn(256))
+		}
+		if _, err := Recognize(random, 0); !errors.Is(err, ErrUnrecognized) {
+			t.Errorf("Recognize of random bits failed: got %v, want %v", err, ErrUnrecognized)
+		}
+		if hyps, err := Recognize(random[:40], 0); !errors.Is(err, ErrUnrecognized) || hyps != nil {
+			t.Errorf("Recognize of short data failed: got (%d, %v), want (0, %v)", len(hyps), err, ErrUnrecognized)
+		}
+		// zero codewords are valid in every configuration
+		if _, err := Recognize(make([]uint8, 300), 0); !errors.Is(err, ErrUnrecognized) {
+			t.Errorf("Recognize of zeros failed: got %v, want %v", err, ErrUnrecognized)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package sim
+
+import (
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/yyyoichi/golay"
+)
+
+// WordErrorBSC returns the probability that a Golay(23,12) codeword is decoded to the
+// wrong data on a binary symmetric channel with crossover probability p.
+// It is computed from the coset leaders of the code: decoding succeeds exactly when
+// the error pattern is the coset leader of its syndrome.
+func WordErrorBSC(p float64) float64 {
+	var correct float64
+	for w, n := range cosetLeaderWeights() {
+		correct += float64(n) * math.Pow(p, float64(w)) * math.Pow(1-p, float64(23-w))
+	}
+	return 1 - correct
+}
+
+// BitErrorBSC returns the probability that a data bit is wrong after decoding a
+// Golay(23,12) codeword received over a binary symmetric channel with crossover probability p.
+// It is computed exactly by classifying all 2^23 error patterns by the number of data bits
+// left in error after decoding.
+func BitErrorBSC(p float64) float64 {
+	var sum float64
+	for w, n := range dataErrors() {
+		sum += float64(n) * math.Pow(p, float64(w)) * math.Pow(1-p, float64(23-w))
+	}
+	return sum / 12
+}
+
+// BPSKBitError returns the raw bit error probability of hard-decision BPSK over AWGN
+// at the given Eb/N0 in dB and code rate, which is the crossover probability
+// seen by a hard-decision decoder.
+func BPSKBitError(ebn0dB, rate float64) float64 {
+	return q(math.Sqrt(2 * rate * math.Pow(10, ebn0dB/10)))
+}
+
+// UnionBoundHard returns union-bound estimates of the word and data bit error probabilities
+// of Golay(23,12) with hard-decision maximum likelihood decoding on BPSK over AWGN
+// at the given Eb/N0 in dB.
+// Each codeword of weight d contributes the probability that more than half of d
+// hard decisions are wrong, with ties counted as one half.
+func UnionBoundHard(ebn0dB float64) (word, bit float64) {
+	p := BPSKBitError(ebn0dB, 12.0/23)
+	return unionBound(func(d int) float64 {
+		var pe float64
+		for i := (d + 1) / 2; i <= d; i++ {
+			term := binomial(d, i) * math.Pow(p, float64(i)) * math.Pow(1-p, float64(d-i))
+			if 2*i == d {
+				term /= 2
+			}
+			pe += term
+		}
+		return pe
+	})
+}
+
+// UnionBoundSoft returns union-bound estimates of the word and data bit error probabilities
+// of Golay(23,12) with soft-decision maximum likelihood decoding on BPSK over AWGN
+// at the given Eb/N0 in dB.
+// Each codeword of weight d contributes Q(sqrt(2·d·R·Eb/N0)).
+func UnionBoundSoft(ebn0dB float64) (word, bit float64) {
+	ebn0 := math.Pow(10, ebn0dB/10)
+	return unionBound(func(d int) float64 {
+		return q(math.Sqrt(2 * float64(d) * 12.0 / 23 * ebn0))
+	})
+}
+
+// UndetectedErrorExtended returns the probability that an error pattern on a binary
+// symmetric channel with crossover probability p turns a codeword of the extended
+// Golay(24,12) code into another codeword, so that a pure error-detecting decoder
+// accepts wrong data.
+// The weight distribution of the extended code is derived from that of Golay(23,12)
+// by appending an overall parity bit.
+func UndetectedErrorExtended(p float64) float64 {
+	var sum float64
+	for w, n := range extendedWeightDistribution() {
+		if w > 0 {
+			sum += float64(n) * math.Pow(p, float64(w)) * math.Pow(1-p, float64(24-w))
+		}
+	}
+	return sum
+}
+
+// unionBound sums pairwise error probabilities over all non-zero codewords.
+// The bit estimate weights each codeword by the fraction of data bits it differs in.
+func unionBound(pairwise func(d int) float64) (word, bit float64) {
+	for d, n := range golay.WeightDistribution() {
+		if d == 0 || n == 0 {
+			continue
+		}
+		word += float64(n) * pairwise(d)
+	}
+	for _, c := range golay.Codewords()[1:] {
+		bit += float64(bits.OnesCount16(c.Data())) / 12 * pairwise(c.Weight())
+	}
+	return word, bit
+}
+
+// cosetLeaderWeights counts the coset leaders of each weight.
+func cosetLeaderWeights() []int {
+	weights := make([]int, 24)
+	for _, c := range golay.CosetLeaders() {
+		weights[c.Weight()]++
+	}
+	return weights
+}
+
+// extendedWeightDistribution returns the weight distribution of the extended Golay(24,12) code.
+func extendedWeightDistribution() []int {
+	dist := make([]int, 25)
+	for w, n := range golay.WeightDistribution() {
+		if w%2 == 1 {
+			w++
+		}
+		dist[w] += n
+	}
+	return dist
+}
+
+var dataErrors = sync.OnceValue(func() []int {
+	// dataErrors()[w] is the total number of wrong data bits after decoding,
+	// summed over all error patterns of weight w.
+	var single [23]uint16
+	for i := range single {
+		single[i] = golay.Codeword(1 << i).Syndrome()
+	}
+	leaders := golay.CosetLeaders()
+
+	sum := make([]int, 24)
+	// walk all error patterns in Gray code order, updating the syndrome incrementally
+	var e uint32
+	var syndrome uint16
+	for i := uint32(1); i < 1<<23; i++ {
+		bit := bits.TrailingZeros32(i)
+		e ^= 1 << bit
+		syndrome ^= single[bit]
+		residual := golay.Codeword(e) ^ leaders[syndrome]
+		sum[bits.OnesCount32(e)] += bits.OnesCount16(residual.Data())
+	}
+	return sum
+})
+
+// q is the tail probability of the standard normal distribution.
+func q(x float64) float64 {
+	return 0.5 * math.Erfc(x/math.Sqrt2)
+}
+
+// binomial returns the binomial coefficient C(n, k).
+func binomial(n, k int) float64 {
+	c := 1.0
+	for i := range k {
+		c = c * float64(n-i) / float64(i+1)
+	}
+	return c
+}
@@ -0,0 +1,77 @@
+package sim
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnalytic(t *testing.T) {
+	t.Run("WordErrorBSC", func(t *testing.T) {
+		for _, p := range []float64{0, 0.001, 0.01, 0.1, 0.5} {
+			var want float64
+			for i := 4; i <= 23; i++ {
+				want += binomial(23, i) * math.Pow(p, float64(i)) * math.Pow(1-p, float64(23-i))
+			}
+			if got := WordErrorBSC(p); math.Abs(got-want) > 1e-12 {
+				t.Errorf("WordErrorBSC(%v) failed: got %v, want %v", p, got, want)
+			}
+		}
+	})
+	t.Run("BitErrorBSC", func(t *testing.T) {
+		if got := BitErrorBSC(0); got != 0 {
+			t.Errorf("BitErrorBSC(0) failed: got %v, want 0", got)
+		}
+		// random data at p=0.5
+		if got := BitErrorBSC(0.5); math.Abs(got-0.5) > 1e-9 {
+			t.Errorf("BitErrorBSC(0.5) failed: got %v, want 0.5", got)
+		}
+		p := 0.05
+		ber := BitErrorBSC(p)
+		if ber >= WordErrorBSC(p) {
+			t.Errorf("BitErrorBSC(%v) failed: %v is not below word error %v", p, ber, WordErrorBSC(p))
+		}
+		r := mustRun(t, NewBSC(p, 2), Config{Blocks: 50000, Seed: 2})
+		if ber < r.BERInterval.Low || r.BERInterval.High < ber {
+			t.Errorf("BitErrorBSC(%v) failed: %v is outside simulated %v", p, ber, r.BERInterval)
+		}
+	})
+	t.Run("UnionBound", func(t *testing.T) {
+		for _, ebn0 := range []float64{3, 5, 7} {
+			hardWord, hardBit := UnionBoundHard(ebn0)
+			softWord, softBit := UnionBoundSoft(ebn0)
+			if softWord >= hardWord || softBit >= hardBit {
+				t.Errorf("UnionBound(%v) failed: soft %v/%v is not below hard %v/%v", ebn0, softWord, softBit, hardWord, hardBit)
+			}
+			if hardBit >= hardWord || softBit >= softWord {
+				t.Errorf("UnionBound(%v) failed: bit estimate is not below word estimate", ebn0)
+			}
+			// the union bound is an upper bound on the exact hard decision word error
+			exact := WordErrorBSC(BPSKBitError(ebn0, 12.0/23))
+			if hardWord < exact {
+				t.Errorf("UnionBoundHard(%v) failed: %v is below exact %v", ebn0, hardWord, exact)
+			}
+		}
+		// at high SNR the soft bound is dominated by the 253 weight 7 codewords
+		word, _ := UnionBoundSoft(10)
+		want := 253 * q(math.Sqrt(2*7*12.0/23*10))
+		if math.Abs(word-want)/want > 0.05 {
+			t.Errorf("UnionBoundSoft(10) failed: got %v, want %v", word, want)
+		}
+	})
+	t.Run("UndetectedErrorExtended", func(t *testing.T) {
+		// any of the 4095 non-zero codewords out of 2^24 patterns
+		if got, want := UndetectedErrorExtended(0.5), 4095.0/(1<<24); math.Abs(got-want) > 1e-15 {
+			t.Errorf("UndetectedErrorExtended(0.5) failed: got %v, want %v", got, want)
+		}
+		p := 1e-3
+		want := 759 * math.Pow(p, 8) * math.Pow(1-p, 16)
+		if got := UndetectedErrorExtended(p); math.Abs(got-want)/want > 1e-3 {
+			t.Errorf("UndetectedErrorExtended(%v) failed: got %v, want %v", p, got, want)
+		}
+	})
+	t.Run("BPSKBitError", func(t *testing.T) {
+		if got, want := BPSKBitError(9.6, 1), 1e-5; math.Abs(got-want)/want > 0.1 {
+			t.Errorf("BPSKBitError(9.6) failed: got %v, want about %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// Package sim provides channel models and a measurement harness for simulating
+// the error-correction performance of the golay package.
+//
+// All channels draw from seeded random number generators, so simulations are reproducible.
+package sim
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Channel transmits words of n bits (right-aligned, MSB first) and returns the received word.
+type Channel interface {
+	Transmit(word uint32, n int) uint32
+}
+
+// BSC is a binary symmetric channel that flips each bit independently with probability p.
+type BSC struct {
+	p   float64
+	rng *rand.Rand
+}
+
+// NewBSC creates a binary symmetric channel with crossover probability p.
+func NewBSC(p float64, seed uint64) *BSC {
+	return &BSC{p: p, rng: newRand(seed)}
+}
+
+// Transmit flips each of the n bits of word with probability p.
+func (c *BSC) Transmit(word uint32, n int) uint32 {
+	for i := range n {
+		if c.rng.Float64() < c.p {
+			word ^= 1 << i
+		}
+	}
+	return word
+}
+
+// GilbertElliott is a two-state burst error channel.
+// In the good state bits are flipped with probability EGood, in the bad state with probability EBad.
+// Before each bit the channel moves from good to bad with probability PGB
+// and from bad to good with probability PBG.
+type GilbertElliott struct {
+	pGB, pBG    float64
+	eGood, eBad float64
+	bad         bool
+	rng         *rand.Rand
+}
+
+// NewGilbertElliott creates a Gilbert–Elliott channel that starts in the good state.
+// pGB and pBG are the state transition probabilities, eGood and eBad the bit error
+// probabilities in the good and bad states.
+func NewGilbertElliott(pGB, pBG, eGood, eBad float64, seed uint64) *GilbertElliott {
+	return &GilbertElliott{pGB: pGB, pBG: pBG, eGood: eGood, eBad: eBad, rng: newRand(seed)}
+}
+
+// Transmit flips the n bits of word according to the current channel state,
+// transmitting from the MSB. The state carries over between calls.
+func (c *GilbertElliott) Transmit(word uint32, n int) uint32 {
+	for i := n - 1; i >= 0; i-- {
+		if c.bad {
+			c.bad = c.rng.Float64() >= c.pBG
+		} else {
+			c.bad = c.rng.Float64() < c.pGB
+		}
+		e := c.eGood
+		if c.bad {
+			e = c.eBad
+		}
+		if c.rng.Float64() < e {
+			word ^= 1 << i
+		}
+	}
+	return word
+}
+
+// AWGN is a BPSK modulated additive white Gaussian noise channel.
+// Bit 0 is sent as +1 and bit 1 as -1, and the receiver observes the symbol plus Gaussian noise.
+type AWGN struct {
+	sigma float64
+	rng   *rand.Rand
+}
+
+// NewAWGN creates a BPSK-over-AWGN channel for the given Eb/N0 in dB and code rate.
+// The code rate scales the energy per transmitted bit, so that Eb refers to information bits;
+// use 12.0/23 for Golay(23,12), or 1 to specify Es/N0 directly.
+func NewAWGN(ebn0dB, rate float64, seed uint64) *AWGN {
+	ebn0 := math.Pow(10, ebn0dB/10)
+	return &AWGN{
+		sigma: math.Sqrt(1 / (2 * rate * ebn0)),
+		rng:   newRand(seed),
+	}
+}
+
+// Transmit sends the n bits of word over the channel and returns the hard decisions.
+func (c *AWGN) Transmit(word uint32, n int) uint32 {
+	var received uint32
+	for i := range n {
+		if c.symbol(word, i) < 0 {
+			received |= 1 << i
+		}
+	}
+	return received
+}
+
+// LLR sends the n bits of word over the channel and stores the log-likelihood ratio
+// log(P(0)/P(1)) of each received bit in llr, MSB first.
+// Positive values favor 0 and the magnitude is the reliability. llr must hold n values.
+func (c *AWGN) LLR(word uint32, n int, llr []float64) {
+	for i := range n {
+		llr[n-1-i] = 2 * c.symbol(word, i) / (c.sigma * c.sigma)
+	}
+}
+
+// symbol returns the received BPSK symbol for the i-th bit (from the LSB) of word.
+func (c *AWGN) symbol(word uint32, i int) float64 {
+	x := 1.0
+	if word&(1<<i) != 0 {
+		x = -1
+	}
+	return x + c.sigma*c.rng.NormFloat64()
+}
+
+// newRand creates a deterministic random number generator from seed.
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9E3779B97F4A7C15))
+}
@@ -0,0 +1,75 @@
+package sim
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestChannel(t *testing.T) {
+	t.Run("BSC", func(t *testing.T) {
+		ch := NewBSC(0.1, 1)
+		flips := 0
+		for range 10000 {
+			flips += bits.OnesCount32(ch.Transmit(0, 23))
+		}
+		if p := float64(flips) / (10000 * 23); math.Abs(p-0.1) > 0.01 {
+			t.Errorf("BSC crossover failed: got %v, want %v", p, 0.1)
+		}
+		if got := NewBSC(0, 1).Transmit(0x7FFFFF, 23); got != 0x7FFFFF {
+			t.Errorf("BSC with p=0 failed: got %#x, want %#x", got, 0x7FFFFF)
+		}
+	})
+	t.Run("GilbertElliott", func(t *testing.T) {
+		// always bad state with certain errors
+		if got := NewGilbertElliott(1, 0, 0, 1, 1).Transmit(0, 23); got != 0x7FFFFF {
+			t.Errorf("GilbertElliott bad state failed: got %#x, want %#x", got, 0x7FFFFF)
+		}
+		// never leaves the good state
+		if got := NewGilbertElliott(0, 1, 0, 1, 1).Transmit(0, 23); got != 0 {
+			t.Errorf("GilbertElliott good state failed: got %#x, want %#x", got, 0)
+		}
+		// errors come in bursts: long runs of errors are more likely than on a BSC
+		ch := NewGilbertElliott(0.01, 0.2, 0, 0.5, 1)
+		flips := 0
+		for range 10000 {
+			flips += bits.OnesCount32(ch.Transmit(0, 23))
+		}
+		// stationary bad state probability is 0.01/(0.01+0.2)
+		want := 0.5 * 0.01 / 0.21
+		if p := float64(flips) / (10000 * 23); math.Abs(p-want) > 0.005 {
+			t.Errorf("GilbertElliott error rate failed: got %v, want %v", p, want)
+		}
+	})
+	t.Run("AWGN", func(t *testing.T) {
+		// uncoded BPSK at Eb/N0 = 4dB: Q(sqrt(2*Eb/N0)) ~= 0.0125
+		ch := NewAWGN(4, 1, 1)
+		flips := 0
+		for range 20000 {
+			flips += bits.OnesCount32(ch.Transmit(0x2AAAAA, 23) ^ 0x2AAAAA)
+		}
+		want := 0.5 * math.Erfc(math.Sqrt(math.Pow(10, 0.4)))
+		if p := float64(flips) / (20000 * 23); math.Abs(p-want) > 0.002 {
+			t.Errorf("AWGN error rate failed: got %v, want %v", p, want)
+		}
+
+		llr := make([]float64, 23)
+		NewAWGN(20, 1, 1).LLR(1<<22, 23, llr)
+		if llr[0] >= 0 {
+			t.Errorf("AWGN.LLR() failed: bit 0 is 1 but LLR %v favors 0", llr[0])
+		}
+		for i, l := range llr[1:] {
+			if l <= 0 {
+				t.Errorf("AWGN.LLR() failed: bit %d is 0 but LLR %v favors 1", i+1, l)
+			}
+		}
+	})
+	t.Run("Seed", func(t *testing.T) {
+		a, b := NewBSC(0.3, 7), NewBSC(0.3, 7)
+		for range 100 {
+			if a.Transmit(0, 23) != b.Transmit(0, 23) {
+				t.Fatalf("BSC with the same seed produced different errors")
+			}
+		}
+	})
+}
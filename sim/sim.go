@@ -0,0 +1,269 @@
+package sim
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/yyyoichi/bitstream-go"
+	"github.com/yyyoichi/golay"
+)
+
+// Mode selects how codewords are encoded and decoded.
+type Mode int
+
+const (
+	// Hard decodes Golay(23,12) from hard decisions with golay.Decode.
+	Hard Mode = iota
+	// Soft decodes Golay(23,12) from log-likelihood ratios with golay.DecodeSoft.
+	// Channels that implement SoftChannel provide real LLRs; for other channels
+	// each hard decision is given the same reliability, which is equivalent to Hard.
+	Soft
+	// Extended encodes with the extended Golay(24,12) code and decodes with
+	// golay.DecodeExtended, which detects 4-bit errors.
+	Extended
+)
+
+// Modes lists all decoding modes.
+var Modes = []Mode{Hard, Soft, Extended}
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case Hard:
+		return "hard"
+	case Soft:
+		return "soft"
+	case Extended:
+		return "extended"
+	default:
+		return "unknown"
+	}
+}
+
+// Rate returns the code rate of the mode.
+func (m Mode) Rate() float64 {
+	if m == Extended {
+		return 12.0 / 24
+	}
+	return 12.0 / 23
+}
+
+// Config controls a simulation run.
+type Config struct {
+	// Mode selects the code and decoder. The zero value is Hard.
+	Mode Mode
+	// Blocks is the number of 12-bit data blocks pushed through the channel.
+	Blocks int
+	// Seed seeds the random data. Channels are seeded separately.
+	Seed uint64
+	// Stream selects the stream Encoder and Decoder instead of EncodeWord and Decode.
+	// Encoded blocks are then transmitted from the packed bitstream.
+	// It is only supported with Hard; Run returns ErrStreamMode for other modes.
+	Stream bool
+}
+
+// ErrStreamMode is returned by Run and the sweeps when Config.Stream is set with a mode other than Hard.
+var ErrStreamMode = errors.New("sim: Stream is only supported with the Hard mode")
+
+// Result holds the measured error rates of a simulation run.
+type Result struct {
+	// Blocks is the number of decoded blocks (frames).
+	Blocks int
+	// Bits is the number of decoded data bits.
+	Bits int
+	// BitErrors is the number of data bits that differ after decoding.
+	BitErrors int
+	// FrameErrors is the number of blocks with at least one data bit error after decoding.
+	FrameErrors int
+	// Detected is the number of blocks reported as uncorrectable by the decoder.
+	// Only the Extended mode detects errors; the data of such blocks is counted as received.
+	Detected int
+	// BER is the bit error rate after decoding and BERInterval its 95% confidence interval.
+	BER         float64
+	BERInterval Interval
+	// FER is the frame error rate after decoding and FERInterval its 95% confidence interval.
+	FER         float64
+	FERInterval Interval
+}
+
+// Interval is a confidence interval for an error rate.
+type Interval struct {
+	Low, High float64
+}
+
+// Point is the result of one channel parameter in a sweep.
+type Point struct {
+	// EbN0 is the Eb/N0 in dB of an AWGN sweep.
+	EbN0 float64
+	// Crossover is the crossover probability of a BSC sweep.
+	Crossover float64
+	Result    Result
+}
+
+// Run pushes cfg.Blocks random data blocks through the Golay code and ch,
+// and measures the bit and frame error rates after decoding.
+func Run(ch Channel, cfg Config) (Result, error) {
+	if cfg.Stream && cfg.Mode != Hard {
+		return Result{}, ErrStreamMode
+	}
+	rng := newRand(cfg.Seed)
+	data := make([]uint16, cfg.Blocks)
+	for i := range data {
+		data[i] = uint16(rng.Uint32()) & 0xFFF
+	}
+
+	var r Result
+	var decoded []uint16
+	switch {
+	case cfg.Mode == Hard && cfg.Stream:
+		decoded = runStream(ch, data)
+	case cfg.Mode == Soft:
+		decoded = make([]uint16, len(data))
+		llr := make([]float64, 23)
+		for i, d := range data {
+			receiveLLR(ch, golay.EncodeWord(d), llr)
+			decoded[i] = golay.DecodeSoft(llr)
+		}
+	case cfg.Mode == Extended:
+		decoded = make([]uint16, len(data))
+		for i, d := range data {
+			var ok bool
+			decoded[i], ok = golay.DecodeExtended(ch.Transmit(golay.EncodeExtended(d), 24))
+			if !ok {
+				r.Detected++
+			}
+		}
+	default:
+		decoded = make([]uint16, len(data))
+		for i, d := range data {
+			decoded[i] = golay.Decode(ch.Transmit(golay.EncodeWord(d), 23))
+		}
+	}
+
+	r.Blocks = len(data)
+	r.Bits = len(data) * 12
+	for i := range data {
+		if e := bits.OnesCount16(data[i] ^ decoded[i]); e > 0 {
+			r.BitErrors += e
+			r.FrameErrors++
+		}
+	}
+	r.BER, r.BERInterval = rate(r.BitErrors, r.Bits)
+	r.FER, r.FERInterval = rate(r.FrameErrors, r.Blocks)
+	return r, nil
+}
+
+// SweepEbN0 runs cfg over a BPSK-over-AWGN channel for each Eb/N0 value in dB,
+// using the code rate of cfg.Mode. The channel of the i-th point is seeded with cfg.Seed+i.
+// It returns the first error of Run.
+func SweepEbN0(ebn0dB []float64, cfg Config) ([]Point, error) {
+	points := make([]Point, len(ebn0dB))
+	for i, ebn0 := range ebn0dB {
+		ch := NewAWGN(ebn0, cfg.Mode.Rate(), cfg.Seed+uint64(i))
+		r, err := Run(ch, cfg)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = Point{EbN0: ebn0, Result: r}
+	}
+	return points, nil
+}
+
+// SweepBSC runs cfg over a binary symmetric channel for each crossover probability.
+// The channel of the i-th point is seeded with cfg.Seed+i.
+// It returns the first error of Run.
+func SweepBSC(crossover []float64, cfg Config) ([]Point, error) {
+	points := make([]Point, len(crossover))
+	for i, p := range crossover {
+		ch := NewBSC(p, cfg.Seed+uint64(i))
+		r, err := Run(ch, cfg)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = Point{Crossover: p, Result: r}
+	}
+	return points, nil
+}
+
+// SoftChannel is a Channel that can also deliver soft decisions.
+type SoftChannel interface {
+	Channel
+	// LLR transmits the n bits of word and stores the log-likelihood ratio
+	// of each received bit in llr, MSB first.
+	LLR(word uint32, n int, llr []float64)
+}
+
+// receiveLLR transmits a 23-bit codeword and stores soft decisions in llr.
+func receiveLLR(ch Channel, codeword uint32, llr []float64) {
+	if sc, ok := ch.(SoftChannel); ok {
+		sc.LLR(codeword, 23, llr)
+		return
+	}
+	received := ch.Transmit(codeword, 23)
+	for i := range llr {
+		llr[i] = 1
+		if received&(1<<(22-i)) != 0 {
+			llr[i] = -1
+		}
+	}
+}
+
+// runStream encodes data with golay.Encoder, transmits each 23-bit block of the
+// packed stream over ch and decodes the received stream with golay.Decoder.
+func runStream(ch Channel, data []uint16) []uint16 {
+	var encoded []uint32
+	encoder := golay.NewEncoder(&encoded)
+	writer := bitstream.NewBitWriter[uint16](0, 0)
+	for _, d := range data {
+		writer.Write16(4, 12, d)
+	}
+	_ = encoder.Encode(writer.Data(), writer.Bits())
+
+	reader := bitstream.NewBitReader(encoded, 0, 0)
+	reader.SetBits(encoder.Bits())
+	received := bitstream.NewBitWriter[uint32](0, 0)
+	for i := range encoder.Bits() / 23 {
+		received.Write32(9, 23, ch.Transmit(reader.Read32R(23, i), 23))
+	}
+
+	var out []uint8
+	decoder := golay.NewDecoder(received.Data(), received.Bits())
+	_ = decoder.Decode(&out)
+	result := bitstream.NewBitReader(out, 0, 0)
+	decoded := make([]uint16, len(data))
+	for i := range decoded {
+		decoded[i] = result.Read16R(12, i)
+	}
+	return decoded
+}
+
+// rate returns the ratio k/n and its 95% Wilson score interval.
+func rate(k, n int) (float64, Interval) {
+	if n == 0 {
+		return 0, Interval{0, 1}
+	}
+	return float64(k) / float64(n), Wilson(k, n, 1.959964)
+}
+
+// Wilson returns the Wilson score interval for k successes in n trials,
+// where z is the standard normal quantile of the confidence level (1.96 for 95%).
+func Wilson(k, n int, z float64) Interval {
+	if n == 0 {
+		return Interval{0, 1}
+	}
+	p := float64(k) / float64(n)
+	nf := float64(n)
+	denom := 1 + z*z/nf
+	center := (p + z*z/(2*nf)) / denom
+	half := z * math.Sqrt(p*(1-p)/nf+z*z/(4*nf*nf)) / denom
+	i := Interval{Low: max(0, center-half), High: min(1, center+half)}
+	// avoid rounding errors at the bounds
+	if k == 0 {
+		i.Low = 0
+	}
+	if k == n {
+		i.High = 1
+	}
+	return i
+}
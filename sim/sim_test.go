@@ -0,0 +1,117 @@
+package sim
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// mustRun runs cfg over ch and fails the test on error.
+func mustRun(t *testing.T, ch Channel, cfg Config) Result {
+	t.Helper()
+	r, err := Run(ch, cfg)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	return r
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Noiseless", func(t *testing.T) {
+		for _, stream := range []bool{false, true} {
+			r := mustRun(t, NewBSC(0, 1), Config{Blocks: 1000, Seed: 1, Stream: stream})
+			if r.BitErrors != 0 || r.FrameErrors != 0 {
+				t.Errorf("Run(stream=%v) failed: got %d bit errors and %d frame errors, want 0", stream, r.BitErrors, r.FrameErrors)
+			}
+			if r.Bits != 12000 || r.Blocks != 1000 {
+				t.Errorf("Run(stream=%v) failed: got %d bits and %d blocks", stream, r.Bits, r.Blocks)
+			}
+		}
+	})
+	t.Run("BSC", func(t *testing.T) {
+		// a frame fails only if more than 3 of 23 bits are flipped
+		p := 0.05
+		var want float64
+		for i := 4; i <= 23; i++ {
+			want += binomial(23, i) * math.Pow(p, float64(i)) * math.Pow(1-p, float64(23-i))
+		}
+		for _, stream := range []bool{false, true} {
+			r := mustRun(t, NewBSC(p, 1), Config{Blocks: 20000, Seed: 1, Stream: stream})
+			if want < r.FERInterval.Low || r.FERInterval.High < want {
+				t.Errorf("Run(stream=%v) FER failed: got %v %v, want %v", stream, r.FER, r.FERInterval, want)
+			}
+			if r.BER > r.FER || r.BER <= 0 {
+				t.Errorf("Run(stream=%v) BER failed: got %v with FER %v", stream, r.BER, r.FER)
+			}
+		}
+	})
+	t.Run("Reproducible", func(t *testing.T) {
+		a := mustRun(t, NewGilbertElliott(0.01, 0.1, 0.001, 0.3, 3), Config{Blocks: 2000, Seed: 5})
+		b := mustRun(t, NewGilbertElliott(0.01, 0.1, 0.001, 0.3, 3), Config{Blocks: 2000, Seed: 5})
+		if a != b {
+			t.Errorf("Run with the same seeds failed: got %+v and %+v", a, b)
+		}
+	})
+	t.Run("Modes", func(t *testing.T) {
+		cfg := Config{Blocks: 20000, Seed: 1}
+		hard := mustRun(t, NewAWGN(3, Hard.Rate(), 1), cfg)
+		cfg.Mode = Soft
+		soft := mustRun(t, NewAWGN(3, Soft.Rate(), 1), cfg)
+		if soft.BERInterval.High >= hard.BERInterval.Low {
+			t.Errorf("Run(Soft) failed: BER %v is not below hard BER %v", soft.BER, hard.BER)
+		}
+		// soft decoding of a hard channel is hard decoding
+		if r := mustRun(t, NewBSC(0.03, 1), cfg); r.FERInterval.High < WordErrorBSC(0.03) || r.FERInterval.Low > WordErrorBSC(0.03) {
+			t.Errorf("Run(Soft) over BSC failed: FER %v, want %v", r.FERInterval, WordErrorBSC(0.03))
+		}
+		cfg.Mode = Extended
+		ext := mustRun(t, NewBSC(0.05, 1), cfg)
+		if ext.Detected == 0 || ext.Detected > ext.FrameErrors {
+			t.Errorf("Run(Extended) failed: got %d detected and %d frame errors", ext.Detected, ext.FrameErrors)
+		}
+	})
+	t.Run("StreamMode", func(t *testing.T) {
+		for _, m := range []Mode{Soft, Extended} {
+			if _, err := Run(NewBSC(0.01, 1), Config{Mode: m, Blocks: 10, Stream: true}); !errors.Is(err, ErrStreamMode) {
+				t.Errorf("Run(%v, Stream) failed: got %v, want %v", m, err, ErrStreamMode)
+			}
+		}
+		if _, err := SweepEbN0([]float64{1}, Config{Mode: Soft, Blocks: 10, Stream: true}); !errors.Is(err, ErrStreamMode) {
+			t.Errorf("SweepEbN0(Soft, Stream) failed: got %v, want %v", err, ErrStreamMode)
+		}
+	})
+	t.Run("SweepBSC", func(t *testing.T) {
+		points, _ := SweepBSC([]float64{0.01, 0.05, 0.1}, Config{Blocks: 5000, Seed: 1})
+		for i, p := range points {
+			if p.Crossover != []float64{0.01, 0.05, 0.1}[i] {
+				t.Errorf("SweepBSC failed: point %d has crossover %v", i, p.Crossover)
+			}
+			if i > 0 && p.Result.FER <= points[i-1].Result.FER {
+				t.Errorf("SweepBSC failed: FER does not increase with the crossover probability")
+			}
+		}
+	})
+	t.Run("SweepEbN0", func(t *testing.T) {
+		points, _ := SweepEbN0([]float64{0, 2, 4, 6}, Config{Blocks: 5000, Seed: 1})
+		if len(points) != 4 {
+			t.Fatalf("SweepEbN0 failed: got %d points, want %d", len(points), 4)
+		}
+		for i := 1; i < len(points); i++ {
+			if points[i].Result.BER >= points[i-1].Result.BER {
+				t.Errorf("SweepEbN0 failed: BER at %vdB (%v) is not below %vdB (%v)",
+					points[i].EbN0, points[i].Result.BER, points[i-1].EbN0, points[i-1].Result.BER)
+			}
+		}
+	})
+}
+
+func TestWilson(t *testing.T) {
+	i := Wilson(0, 100, 1.96)
+	if i.Low != 0 || i.High < 0.03 || i.High > 0.04 {
+		t.Errorf("Wilson(0, 100) failed: got %+v", i)
+	}
+	i = Wilson(50, 100, 1.96)
+	if math.Abs(i.Low-0.4038) > 1e-3 || math.Abs(i.High-0.5962) > 1e-3 {
+		t.Errorf("Wilson(50, 100) failed: got %+v", i)
+	}
+}
@@ -0,0 +1,105 @@
+package golay
+
+import "unsafe"
+
+// EncodeSlice performs Golay encoding on MSB-aligned data like EncodeBinay,
+// but without reflection: input and output types are checked at compile time,
+// and named slice types such as `type Bytes []byte` are accepted.
+// The bits parameter specifies how many bits in data are valid; if bits is 0,
+// all bits in data are considered valid.
+// The result overwrites *v, reusing its backing array when the capacity is sufficient,
+// so that encoding into a preallocated slice does not allocate.
+// Returns the number of valid encoded bits in *v.
+func EncodeSlice[I, O BinaryValue, S ~[]O](data []I, bits int, v *S) int {
+	bits = validBits(data, bits)
+	numBlocks := (bits + 11) / 12
+	out := resize(*v, numBlocks*23)
+	for i := range numBlocks {
+		b := uint16(getBits(data, i*12, min(12, bits-i*12)))
+		if bits-i*12 < 12 {
+			// zero-pad the last block
+			b <<= 12 - (bits - i*12)
+		}
+		putBits(out, i*23, 23, EncodeWord(b))
+	}
+	*v = out
+	return numBlocks * 23
+}
+
+// DecodeSlice performs Golay decoding on MSB-aligned data like DecodeBinay,
+// but without reflection: input and output types are checked at compile time,
+// and named slice types such as `type Bytes []byte` are accepted.
+// The bits parameter specifies how many bits in data are valid; if bits is 0,
+// all bits in data are considered valid. Any remainder after the last complete
+// 23-bit block is ignored.
+// The result overwrites *v, reusing its backing array when the capacity is sufficient,
+// so that decoding into a preallocated slice does not allocate.
+// Returns the number of valid decoded bits in *v.
+func DecodeSlice[I, O BinaryValue, S ~[]O](data []I, bits int, v *S) int {
+	bits = validBits(data, bits)
+	numBlocks := bits / 23
+	out := resize(*v, numBlocks*12)
+	for i := range numBlocks {
+		cw := getBits(data, i*23, 23)
+		putBits(out, i*12, 12, uint32(Decode(cw)))
+	}
+	*v = out
+	return numBlocks * 12
+}
+
+// bitSize returns the size of T in bits.
+func bitSize[T BinaryValue]() int {
+	return int(unsafe.Sizeof(T(0))) * 8
+}
+
+// validBits returns the number of valid bits in data.
+// bits <= 0 means all bits, and bits exceeding data are capped.
+func validBits[T BinaryValue](data []T, bits int) int {
+	if limit := len(data) * bitSize[T](); bits <= 0 || bits > limit {
+		return limit
+	}
+	return bits
+}
+
+// resize returns a zeroed slice that can hold the given number of bits,
+// reusing the backing array of s when possible.
+func resize[S ~[]T, T BinaryValue](s S, bits int) S {
+	size := bitSize[T]()
+	n := (bits + size - 1) / size
+	if cap(s) < n {
+		return make(S, n)
+	}
+	s = s[:n]
+	clear(s)
+	return s
+}
+
+// getBits reads n bits (up to 32) starting at bit position pos of MSB-aligned data.
+// The bits are returned right-aligned.
+func getBits[T BinaryValue](data []T, pos, n int) uint32 {
+	size := bitSize[T]()
+	var b uint64
+	for n > 0 {
+		idx, off := pos/size, pos%size
+		take := min(n, size-off)
+		chunk := uint64(data[idx]) >> (size - off - take) & (1<<take - 1)
+		b = b<<take | chunk
+		pos += take
+		n -= take
+	}
+	return uint32(b)
+}
+
+// putBits writes the right-aligned n bits (up to 32) of b at bit position pos of
+// MSB-aligned data. The destination bits must be zero.
+func putBits[T BinaryValue](data []T, pos, n int, b uint32) {
+	size := bitSize[T]()
+	for n > 0 {
+		idx, off := pos/size, pos%size
+		take := min(n, size-off)
+		chunk := uint64(b) >> (n - take) & (1<<take - 1)
+		data[idx] |= T(chunk << (size - off - take))
+		pos += take
+		n -= take
+	}
+}
@@ -0,0 +1,153 @@
+package golay
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type byteSlice []uint8
+
+func TestSlice(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		var v []uint32
+		// 16bit -> 2 block -> 23bit x 2 = 46bit -> 2 uint32
+		if n := EncodeSlice([]uint8{0xFF, 0xF0}, 0, &v); n != 46 {
+			t.Fatalf("EncodeSlice bits failed: got %d, want %d", n, 46)
+		}
+		if !slices.Equal(v, []uint32{0xFFFFFE00, 0}) {
+			t.Errorf("EncodeSlice failed: got %#x, want %#x", v, []uint32{0xFFFFFE00, 0})
+		}
+		// 12bit -> 1 block -> 1 uint32
+		if n := EncodeSlice([]uint16{0xFFF0}, 12, &v); n != 23 {
+			t.Fatalf("EncodeSlice bits failed: got %d, want %d", n, 23)
+		}
+		if !slices.Equal(v, []uint32{0xFFFFFE00}) {
+			t.Errorf("EncodeSlice failed: got %#x, want %#x", v, []uint32{0xFFFFFE00})
+		}
+	})
+	t.Run("Decode", func(t *testing.T) {
+		var v []uint16
+		// 32bit -> 2block -> 24bit -> 2 uint16
+		if n := DecodeSlice([]uint32{0xFFFFFE00, 0}, 0, &v); n != 24 {
+			t.Fatalf("DecodeSlice bits failed: got %d, want %d", n, 24)
+		}
+		if !slices.Equal(v, []uint16{0xFFF0, 0}) {
+			t.Errorf("DecodeSlice failed: got %#x, want %#x", v, []uint16{0xFFF0, 0})
+		}
+		// 23bit -> 1block -> 12bit -> 1 uint16
+		if n := DecodeSlice([]uint32{0xFFFFFE00, 0}, 23, &v); n != 12 {
+			t.Fatalf("DecodeSlice bits failed: got %d, want %d", n, 12)
+		}
+		if !slices.Equal(v, []uint16{0xFFF0}) {
+			t.Errorf("DecodeSlice failed: got %#x, want %#x", v, []uint16{0xFFF0})
+		}
+	})
+	t.Run("NamedType", func(t *testing.T) {
+		var encoded byteSlice
+		EncodeSlice(byteSlice{0xAB, 0xCD, 0xEF}, 0, &encoded)
+		var decoded byteSlice
+		DecodeSlice(encoded, 0, &decoded)
+		if !slices.Equal(decoded, byteSlice{0xAB, 0xCD, 0xEF}) {
+			t.Errorf("DecodeSlice failed: got %#x, want %#x", decoded, byteSlice{0xAB, 0xCD, 0xEF})
+		}
+	})
+	t.Run("CompatibleWithEncoder", func(t *testing.T) {
+		for range 100 {
+			data := make([]uint64, rand.Intn(32)+1)
+			for i := range data {
+				data[i] = rand.Uint64()
+			}
+			bits := rand.Intn(len(data)*64) + 1
+			testSliceCompatible[uint64, uint8](t, data, bits)
+			testSliceCompatible[uint64, uint16](t, data, bits)
+			testSliceCompatible[uint64, uint32](t, data, bits)
+			testSliceCompatible[uint64, uint64](t, data, bits)
+			testSliceCompatible[uint64, uint](t, data, bits)
+		}
+	})
+	t.Run("ZeroAllocation", func(t *testing.T) {
+		data := make([]uint8, 1024)
+		encoded := make([]uint32, 0, 1024)
+		decoded := make([]uint8, 0, 1024)
+		allocs := testing.AllocsPerRun(10, func() {
+			EncodeSlice(data, 0, &encoded)
+			DecodeSlice(encoded, 0, &decoded)
+		})
+		if allocs != 0 {
+			t.Errorf("EncodeSlice/DecodeSlice allocated %v times, want 0", allocs)
+		}
+	})
+}
+
+func testSliceCompatible[I, O BinaryValue](t *testing.T, data []I, bits int) {
+	t.Helper()
+	var want []O
+	enc := NewEncoder(&want)
+	_ = enc.Encode(data, bits)
+	var got []O
+	if n := EncodeSlice(data, bits, &got); n != enc.Bits() {
+		t.Fatalf("EncodeSlice bits failed: got %d, want %d", n, enc.Bits())
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("EncodeSlice failed: output differs from Encoder")
+	}
+
+	var wantDecoded []I
+	dec := NewDecoder(want, enc.Bits())
+	_ = dec.Decode(&wantDecoded)
+	var gotDecoded []I
+	if n := DecodeSlice(got, enc.Bits(), &gotDecoded); n != dec.Bits() {
+		t.Fatalf("DecodeSlice bits failed: got %d, want %d", n, dec.Bits())
+	}
+	if !slices.Equal(gotDecoded, wantDecoded) {
+		t.Fatalf("DecodeSlice failed: output differs from Decoder")
+	}
+}
+
+func BenchmarkEncodeBinay(b *testing.B) {
+	data := benchmarkData()
+	b.ReportAllocs()
+	for b.Loop() {
+		var v []uint32
+		_ = EncodeBinay(data, &v)
+	}
+}
+
+func BenchmarkEncodeSlice(b *testing.B) {
+	data := benchmarkData()
+	v := make([]uint32, 0, len(data))
+	b.ReportAllocs()
+	for b.Loop() {
+		EncodeSlice(data, 0, &v)
+	}
+}
+
+func BenchmarkDecodeBinay(b *testing.B) {
+	var encoded []uint32
+	_ = EncodeBinay(benchmarkData(), &encoded)
+	b.ReportAllocs()
+	for b.Loop() {
+		var v []uint8
+		_ = DecodeBinay(encoded, &v)
+	}
+}
+
+func BenchmarkDecodeSlice(b *testing.B) {
+	var encoded []uint32
+	EncodeSlice(benchmarkData(), 0, &encoded)
+	v := make([]uint8, 0, len(encoded)*4)
+	b.ReportAllocs()
+	for b.Loop() {
+		DecodeSlice(encoded, 0, &v)
+	}
+}
+
+func benchmarkData() []uint8 {
+	r := rand.New(rand.NewSource(1))
+	data := make([]uint8, 4096)
+	for i := range data {
+		data[i] = uint8(r.Intn(256))
+	}
+	return data
+}
@@ -0,0 +1,62 @@
+package golay
+
+import "math"
+
+// DecodeSoft decodes a 23-bit Golay(23,12) codeword from soft decisions into 12-bit data.
+// llr holds the log-likelihood ratio log(P(0)/P(1)) of each of the 23 received bits,
+// MSB (first data bit) first: positive values favor 0 and the magnitude is the reliability.
+// It panics if llr does not hold 23 values.
+//
+// Decoding uses the Chase-II algorithm: the 4 least reliable hard decisions are flipped
+// in all 16 combinations, each test word is decoded with Decode, and the candidate
+// codeword with the smallest total reliability of disagreeing bits is chosen.
+// This corrects every error pattern Decode corrects and many patterns of 4 or more errors.
+func DecodeSoft(llr []float64) uint16 {
+	if len(llr) != 23 {
+		panic("llr must hold 23 values")
+	}
+	var hard uint32
+	for i, l := range llr {
+		if l < 0 {
+			hard |= 1 << (22 - i)
+		}
+	}
+
+	// find the 4 least reliable positions
+	var weak [4]int
+	for k := range weak {
+		weak[k] = -1
+	}
+	for i, l := range llr {
+		r := math.Abs(l)
+		for k := range weak {
+			if weak[k] < 0 || r < math.Abs(llr[weak[k]]) {
+				copy(weak[k+1:], weak[k:])
+				weak[k] = i
+				break
+			}
+		}
+	}
+
+	best, bestMetric := uint16(0), math.Inf(1)
+	for t := range 1 << len(weak) {
+		test := hard
+		for k, i := range weak {
+			if t&(1<<k) != 0 {
+				test ^= 1 << (22 - i)
+			}
+		}
+		d := Decode(test)
+		diff := EncodeWord(d) ^ hard
+		var metric float64
+		for i, l := range llr {
+			if diff&(1<<(22-i)) != 0 {
+				metric += math.Abs(l)
+			}
+		}
+		if metric < bestMetric {
+			best, bestMetric = d, metric
+		}
+	}
+	return best
+}
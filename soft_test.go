@@ -0,0 +1,43 @@
+package golay
+
+import "testing"
+
+func TestDecodeSoft(t *testing.T) {
+	llr := make([]float64, 23)
+	set := func(c uint32) {
+		for i := range llr {
+			if c&(1<<(22-i)) != 0 {
+				llr[i] = -2
+			} else {
+				llr[i] = 2
+			}
+		}
+	}
+	var max uint16 = 1<<12 - 1
+	for d := range max {
+		c := EncodeWord(d)
+		set(c)
+		if r := DecodeSoft(llr); r != d {
+			t.Fatalf("DecodeSoft failed for data %d: got %d", d, r)
+		}
+		// 3 confident errors are corrected like Decode
+		set(c ^ 1<<3 ^ 1<<11 ^ 1<<20)
+		if r := DecodeSoft(llr); r != d {
+			t.Fatalf("DecodeSoft failed for data %d with 3-bit errors: got %d", d, r)
+		}
+		// 4 errors are corrected when 2 of them are unreliable
+		set(c ^ 1<<0 ^ 1<<7 ^ 1<<14 ^ 1<<22)
+		llr[22-14] /= 10
+		llr[22-22] /= 10
+		if r := DecodeSoft(llr); r != d {
+			t.Fatalf("DecodeSoft failed for data %d with 4-bit errors: got %d", d, r)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("DecodeSoft should panic on a short input")
+		}
+	}()
+	DecodeSoft(make([]float64, 22))
+}
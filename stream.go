@@ -1,7 +1,8 @@
 package golay
 
 import (
-	"errors"
+	"fmt"
+	"math/bits"
 	"reflect"
 
 	"github.com/yyyoichi/bitstream-go"
@@ -21,18 +22,20 @@ func EncodeBinay[I, O BinaryValue](data []I, v *[]O) error {
 	return encoder.Encode(data, 0)
 }
 
-// Encoder performs Golay encoding on MSB-aligned binary data.
+// Encoder performs Golay encoding on binary data, MSB-aligned unless set otherwise by SetBitOrder.
 // It writes encoded data to an output slice specified at creation time.
 // Input data is split into 12-bit blocks, and each block is encoded
 // into a 23-bit Golay codeword (12 data bits + 11 parity bits).
 // Multiple Encode calls can be made to append additional encoded data.
 type Encoder[T BinaryValue] struct {
-	writer interface {
-		Write16(int, int, uint16)
-		AnyData() any
-	}
+	writer    sliceWriter
 	outputPtr *[]T
 	bits      int
+	crc       CRC
+	in, out   BitOrder
+	extended  bool
+	// reversed is the output with LSBFirst element order, updated incrementally.
+	reversed []T
 }
 
 // NewEncoder creates a new Encoder that writes encoded data to v.
@@ -43,29 +46,66 @@ func NewEncoder[T BinaryValue](v *[]T) *Encoder[T] {
 	if v == nil {
 		panic("v must not be nil")
 	}
-	var writer interface {
-		Write16(int, int, uint16)
-		AnyData() any
+	return &Encoder[T]{
+		writer:    newSliceWriter[T](),
+		outputPtr: v,
 	}
+}
+
+// sliceWriter is the subset of bitstream.BitWriter used by the stream API.
+type sliceWriter interface {
+	Write16(int, int, uint16)
+	Write64(int, int, uint64)
+	AnyData() any
+}
+
+// newSliceWriter creates a bit writer whose data is a []T.
+func newSliceWriter[T BinaryValue]() sliceWriter {
 	var zero T
 	switch any(zero).(type) {
 	case uint64:
-		writer = bitstream.NewBitWriter[uint64](0, 0)
+		return bitstream.NewBitWriter[uint64](0, 0)
 	case uint32:
-		writer = bitstream.NewBitWriter[uint32](0, 0)
+		return bitstream.NewBitWriter[uint32](0, 0)
 	case uint16:
-		writer = bitstream.NewBitWriter[uint16](0, 0)
+		return bitstream.NewBitWriter[uint16](0, 0)
 	case uint8:
-		writer = bitstream.NewBitWriter[uint8](0, 0)
+		return bitstream.NewBitWriter[uint8](0, 0)
 	case uint:
-		writer = bitstream.NewBitWriter[uint](0, 0)
+		return bitstream.NewBitWriter[uint](0, 0)
 	default:
 		panic("slice element type must satisfy BinaryValue constraint")
 	}
-	return &Encoder[T]{
-		writer:    writer,
-		outputPtr: v,
+}
+
+// SetCRC enables CRC framing for subsequent Encode calls.
+// Each Encode call then encodes one frame: the data, zero padding, and the check value of crc,
+// placed so that it occupies the last bits of the final block.
+// The check value is computed over all frame bits that precede it, packed MSB-first into bytes
+// with the last byte zero-padded. Frames are verified by a Decoder with the same CRC.
+// The zero CRC disables framing. It panics if the CRC width is not 8, 16 or 32.
+func (e *Encoder[T]) SetCRC(crc CRC) {
+	if err := crc.validate(); err != nil {
+		panic(err.Error())
 	}
+	e.crc = crc
+}
+
+// SetExtended selects the extended Golay(24,12) code for subsequent Encode calls:
+// every block is encoded with EncodeExtended into a 24-bit codeword, which a Decoder
+// with SetExtended(true) decodes. The default is the Golay(23,12) code.
+func (e *Encoder[T]) SetExtended(extended bool) {
+	e.extended = extended
+}
+
+// SetBitOrder sets the bit order of the input data of subsequent Encode calls
+// and of the whole output slice. The default is MSBFirst for both.
+// With LSBFirst, the first bit of each element is its least significant bit,
+// for protocols that transmit bytes LSB-first. The bits parameter of Encode and Bits still
+// count bits in transmission order, and CRC framing covers the bits in that order.
+func (e *Encoder[T]) SetBitOrder(input, output BitOrder) {
+	e.in, e.out = input, output
+	e.reversed = nil
 }
 
 // Encode performs Golay encoding on the given data and appends the result to the output slice.
@@ -77,47 +117,37 @@ func NewEncoder[T BinaryValue](v *[]T) *Encoder[T] {
 // This method can be called multiple times to encode different data into the same output slice.
 func (e *Encoder[T]) Encode(data any, bits int) error {
 	if data == nil {
-		return errors.New("data must not be nil")
+		return ErrNilData
 	}
 
 	// Create reader based on input data type
 	rv := reflect.ValueOf(data)
 	if rv.Kind() != reflect.Slice {
-		return errors.New("data must be a slice")
+		return ErrNotSlice
 	}
-
-	var reader interface {
-		SetBits(int)
-		Read16R(int, int) uint16
-		Bits() int
+	if e.in == LSBFirst {
+		rv = reflect.ValueOf(reverseAny(data))
 	}
 
-	switch rv.Type().Elem().Kind() {
-	case reflect.Uint64:
-		d := rv.Interface().([]uint64)
-		reader = bitstream.NewBitReader(d, 0, 0)
-	case reflect.Uint32:
-		d := rv.Interface().([]uint32)
-		reader = bitstream.NewBitReader(d, 0, 0)
-	case reflect.Uint16:
-		d := rv.Interface().([]uint16)
-		reader = bitstream.NewBitReader(d, 0, 0)
-	case reflect.Uint8:
-		d := rv.Interface().([]uint8)
-		reader = bitstream.NewBitReader(d, 0, 0)
-	case reflect.Uint:
-		d := rv.Interface().([]uint)
-		reader = bitstream.NewBitReader(d, 0, 0)
-	default:
-		return errors.New("data slice element type must satisfy BinaryValue constraint")
+	reader, err := newSliceReader(rv)
+	if err != nil {
+		return err
 	}
 	if bits > 0 {
 		reader.SetBits(bits)
 	}
+	if e.crc.Width > 0 {
+		reader = newFrame(reader, e.crc)
+	}
 
 	numBlocks := (reader.Bits() + 11) / 12
 	for i := range numBlocks {
 		b := reader.Read16R(12, i)
+		if e.extended {
+			// right 24 bits are the extended codeword
+			e.writer.Write64(40, 24, uint64(EncodeExtended(b)))
+			continue
+		}
 		// right 12 bits are data
 		e.writer.Write16(4, 12, b)
 		p := Encode(b)
@@ -125,10 +155,23 @@ func (e *Encoder[T]) Encode(data any, bits int) error {
 		e.writer.Write16(5, 11, p)
 	}
 
-	e.bits += numBlocks * 23
+	prev := e.bits
+	if e.extended {
+		e.bits += numBlocks * 24
+	} else {
+		e.bits += numBlocks * 23
+	}
 
 	// Write result back to the output slice
 	result := e.writer.AnyData()
+	if e.out == LSBFirst {
+		// only the elements from the one holding the first new bit have changed,
+		// including the previously last, partially written element
+		data := result.([]T)
+		from := min(prev/bitSize[T](), len(e.reversed))
+		e.reversed = append(e.reversed[:from], reverseElements(data[from:])...)
+		result = e.reversed
+	}
 	resultRV := reflect.ValueOf(result)
 	outputRV := reflect.ValueOf(e.outputPtr).Elem()
 	outputRV.Set(resultRV)
@@ -136,6 +179,55 @@ func (e *Encoder[T]) Encode(data any, bits int) error {
 	return nil
 }
 
+// newFrame returns a reader over a CRC frame holding the data of reader,
+// zero padding and the check value of crc in the last bits of the final block.
+func newFrame(reader sliceReader, crc CRC) sliceReader {
+	dataBits := reader.Bits()
+	frameBits := (dataBits + crc.Width + 11) / 12 * 12
+	covered := frameBits - crc.Width
+	frame := make([]uint8, (frameBits+7)/8)
+	for pos := 0; pos < dataBits; pos += 12 {
+		n := min(12, dataBits-pos)
+		putBits(frame, pos, n, uint32(reader.Read16R(12, pos/12)>>(12-n)))
+	}
+	putBits(frame, covered, crc.Width, frameChecksum(crc, frame, covered))
+	r := bitstream.NewBitReader(frame, 0, 0)
+	r.SetBits(frameBits)
+	return r
+}
+
+// sliceReader is the subset of bitstream.BitReader used by the stream API.
+type sliceReader interface {
+	SetBits(int)
+	Read16R(int, int) uint16
+	Read32R(int, int) uint32
+	ReadBit() (bool, error)
+	Bits() int
+}
+
+// newSliceReader creates a bit reader over rv, which must be a slice of BinaryValue type.
+func newSliceReader(rv reflect.Value) (sliceReader, error) {
+	switch rv.Type().Elem().Kind() {
+	case reflect.Uint64:
+		d := rv.Interface().([]uint64)
+		return bitstream.NewBitReader(d, 0, 0), nil
+	case reflect.Uint32:
+		d := rv.Interface().([]uint32)
+		return bitstream.NewBitReader(d, 0, 0), nil
+	case reflect.Uint16:
+		d := rv.Interface().([]uint16)
+		return bitstream.NewBitReader(d, 0, 0), nil
+	case reflect.Uint8:
+		d := rv.Interface().([]uint8)
+		return bitstream.NewBitReader(d, 0, 0), nil
+	case reflect.Uint:
+		d := rv.Interface().([]uint)
+		return bitstream.NewBitReader(d, 0, 0), nil
+	default:
+		return nil, fmt.Errorf("%w in data", ErrElemType)
+	}
+}
+
 // Bits returns the total number of bits that have been encoded so far.
 // This accumulates across multiple Encode calls on the same Encoder.
 // Each 12-bit input block is encoded into a 23-bit Golay codeword.
@@ -160,16 +252,37 @@ func DecodeBinay[I, O BinaryValue](data []I, v *[]O) error {
 	return decoder.Decode(v)
 }
 
-// Decoder performs Golay decoding on MSB-aligned binary data.
+// Decoder performs Golay decoding on binary data, MSB-aligned unless set otherwise by SetBitOrder.
 // It splits the input data into 23-bit blocks and decodes each block
 // into a 12-bit data value.
 type Decoder[T BinaryValue] struct {
-	reader *bitstream.BitReader[T]
+	data     []T
+	reader   *bitstream.BitReader[T]
+	hook     CorrectionHook
+	policy   Policy
+	rejected []int
+	known    []knownBits
+	crc      CRC
+	// requested is the bits argument of NewDecoder, 0 meaning all bits.
+	requested int
+	mode      LengthMode
+	remainder []uint8
+	remBits   int
+	in, out   BitOrder
+	// reorder is set when the input order changed since the reader was built.
+	reorder  bool
+	extended bool
+}
+
+// knownBits holds the known data bits of one block.
+type knownBits struct {
+	mask, value uint16
 }
 
 // NewDecoder creates a new Decoder for MSB-aligned data.
 // The bits parameter specifies how many bits in the input data are valid.
-// It expects bits to be a multiple of 23; any remainder will be ignored.
+// It expects bits to be a multiple of 23; any remainder will be ignored
+// unless a different LengthMode is set with SetLengthMode.
 // For example, if data contains 64-bit values but only 23 bits are valid,
 // setting bits=23 results in only one Golay decoding operation instead of two.
 func NewDecoder[T BinaryValue](data []T, bits int) *Decoder[T] {
@@ -178,66 +291,262 @@ func NewDecoder[T BinaryValue](data []T, bits int) *Decoder[T] {
 		reader.SetBits(bits)
 	}
 	return &Decoder[T]{
-		reader: reader,
+		data:      data,
+		reader:    reader,
+		requested: max(bits, 0),
 	}
 }
 
+// SetBitOrder sets the bit order of the input data and of the output of subsequent Decode calls.
+// The default is MSBFirst for both. With LSBFirst, the first bit of each element is its
+// least significant bit, for protocols that transmit bytes LSB-first. The bits parameter
+// of NewDecoder and Bits still count bits in transmission order, and known bits,
+// CRC verification and Remainder refer to the bits in that order, MSB-aligned.
+// The input is converted once, by the next Decode call, so SetBitOrder is cheap to call
+// at any time.
+func (d *Decoder[T]) SetBitOrder(input, output BitOrder) {
+	if input != d.in {
+		d.in = input
+		d.reorder = true
+	}
+	d.out = output
+}
+
+// applyBitOrder rebuilds the reader in MSB-first order if the input order has changed.
+func (d *Decoder[T]) applyBitOrder() {
+	if !d.reorder {
+		return
+	}
+	d.reorder = false
+	data := d.data
+	if d.in == LSBFirst {
+		data = reverseElements(data)
+	}
+	d.reader = bitstream.NewBitReader(data, 0, 0)
+	if d.requested > 0 {
+		d.reader.SetBits(d.requested)
+	}
+}
+
+// SetExtended selects the extended Golay(24,12) code for subsequent Decode calls:
+// the input is split into 24-bit codewords as produced by EncodeExtended, and the overall
+// parity bit lets the decoder detect 4-bit errors, which Golay(23,12) would miscorrect.
+// Such blocks are rejected like blocks rejected by the policy: their received data bits
+// are written to the output, Rejected reports them and Decode returns a *DecodeError
+// caused by ErrRejected. Blocks with known bits are decoded with DecodeKnown without this check.
+// The default is the Golay(23,12) code.
+func (d *Decoder[T]) SetExtended(extended bool) {
+	d.extended = extended
+}
+
+// blockBits returns the number of bits of one codeword.
+func (d *Decoder[T]) blockBits() int {
+	if d.extended {
+		return 24
+	}
+	return 23
+}
+
+// SetLengthMode sets how subsequent Decode calls handle inputs that are not a whole number
+// of blocks. The default is IgnoreRemainder.
+func (d *Decoder[T]) SetLengthMode(mode LengthMode) {
+	d.mode = mode
+}
+
+// Remainder returns the bits that followed the last complete block in the last Decode call
+// in LenientLength mode, MSB-aligned, and their number. It returns nil and 0 in other modes.
+// If the bits argument of NewDecoder was 0, the remainder includes the padding of the last element.
+// The remainder can be prepended to further data, for example by passing it to a ChunkDecoder.
+func (d *Decoder[T]) Remainder() ([]uint8, int) {
+	return d.remainder, d.remBits
+}
+
+// SetCorrectionHook registers hook to be called for every block that required error correction
+// during subsequent Decode calls. The Block field of each Correction is the block index
+// within the input data. Passing nil removes the hook.
+func (d *Decoder[T]) SetCorrectionHook(hook CorrectionHook) {
+	d.hook = hook
+}
+
+// SetPolicy sets the decoding policy for subsequent Decode calls. The default is CorrectAll.
+// Blocks that need more corrections than the policy allows are rejected:
+// their received data bits are written to the output uncorrected,
+// their indices are reported by Rejected, and Decode returns a *DecodeError caused by ErrRejected.
+func (d *Decoder[T]) SetPolicy(policy Policy) {
+	d.policy = policy
+}
+
+// Rejected returns the indices of the blocks rejected by the policy during the last Decode call,
+// in ascending order. It returns nil if no block was rejected.
+func (d *Decoder[T]) Rejected() []int {
+	return d.rejected
+}
+
+// SetKnownBits sets data bits that are known in advance, such as fixed header fields,
+// for subsequent Decode calls. mask and value are MSB-aligned bit strings over the decoded
+// data: bits set in mask are known and take their value from value. Bits beyond the end
+// of mask are unknown. Blocks with known bits are decoded with DecodeKnown, which can correct
+// more than 3 errors under CorrectAll. Other policies reject such a block, like any other,
+// if its correction has more errors than the policy corrects.
+// mask and value must have the same length. Passing nil clears the known bits.
+func (d *Decoder[T]) SetKnownBits(mask, value []uint8) {
+	if len(mask) != len(value) {
+		panic("mask and value must have the same length")
+	}
+	d.known = make([]knownBits, (len(mask)*8+11)/12)
+	for p := range len(mask) * 8 {
+		bit := uint16(1) << (11 - p%12)
+		if mask[p/8]&(0x80>>(p%8)) != 0 {
+			d.known[p/12].mask |= bit
+			if value[p/8]&(0x80>>(p%8)) != 0 {
+				d.known[p/12].value |= bit
+			}
+		}
+	}
+}
+
+// SetCRC enables verification of frames produced by an Encoder with the same CRC.
+// The input is decoded as one frame: the check value in the last bits of the final block
+// is compared with the CRC of the preceding decoded bits, and Decode returns an
+// *IntegrityError if they differ. The check value is not written to the output.
+// The zero CRC disables verification. It panics if the CRC width is not 8, 16 or 32.
+func (d *Decoder[T]) SetCRC(crc CRC) {
+	if err := crc.validate(); err != nil {
+		panic(err.Error())
+	}
+	d.crc = crc
+}
+
 // Decode performs Golay decoding and stores the result in v.
 // v must be a pointer to a slice of BinaryValue type.
 // The output type can be flexibly specified (e.g., *[]uint32, *[]uint8).
+// Invalid arguments return one of the sentinel errors such as ErrNotSlicePointer.
+// If blocks are rejected by the policy or the CRC does not match, the output is
+// still stored and a *DecodeError is returned.
 func (d *Decoder[T]) Decode(v any) error {
+	writer, rv, err := newOutputWriter(v)
+	if err != nil {
+		return err
+	}
+
+	d.applyBitOrder()
+	d.remainder, d.remBits = nil, 0
+	n := d.blockBits()
+	if err := checkLength(d.mode, d.requested, len(d.reader.Data())*bitSize[T](), bitSize[T](), n); err != nil {
+		return err
+	}
+
+	d.rejected = nil
+	numBlocks := d.reader.Bits() / n
+	if d.mode == LenientLength {
+		d.remBits = d.reader.Bits() - numBlocks*n
+		d.remainder = make([]uint8, (d.remBits+7)/8)
+		for pos := 0; pos < d.remBits; pos += 8 {
+			k := min(8, d.remBits-pos)
+			putBits(d.remainder, pos, k, getBits(d.reader.Data(), numBlocks*n+pos, k))
+		}
+	}
+	outBits := d.Bits()
+	if d.crc.Width > 0 && numBlocks*12 < d.crc.Width {
+		return ErrShortData
+	}
+	var frame []uint8
+	if d.crc.Width > 0 {
+		frame = make([]uint8, (numBlocks*12+7)/8)
+	}
+	for i := range numBlocks {
+		cw := d.reader.Read32R(n, i)
+		ext := cw
+		if d.extended {
+			cw >>= 1
+		}
+		var b uint16
+		if i < len(d.known) && d.known[i].mask != 0 {
+			var pattern uint32
+			b, pattern = decodeKnown(cw, d.known[i].mask, d.known[i].value)
+			if !d.policy.accepts(bits.OnesCount32(pattern)) {
+				// rejected like any other block: keep the received data bits
+				b = uint16(cw >> 11)
+				d.rejected = append(d.rejected, i)
+			} else if pattern != 0 && d.hook != nil {
+				d.hook(Correction{Block: i, Syndrome: syndromeOf(cw), Pattern: pattern})
+			}
+		} else if d.extended && detectedExtended(ext) {
+			b = uint16(cw >> 11)
+			d.rejected = append(d.rejected, i)
+		} else {
+			var ok bool
+			b, ok = decodeBlock(i, cw, d.hook, d.policy)
+			if !ok {
+				d.rejected = append(d.rejected, i)
+			}
+		}
+		if frame != nil {
+			putBits(frame, i*12, 12, uint32(b))
+		}
+		// right 12 bits are data, truncated before the CRC
+		if k := min(12, outBits-i*12); k > 0 {
+			writer.Write16(16-k, k, b>>(12-k))
+		}
+	}
+	data := writer.AnyData()
+	if d.out == LSBFirst {
+		data = reverseAny(data)
+	}
+	rv.Elem().Set(reflect.ValueOf(data))
+
+	if frame != nil {
+		received := getBits(frame, outBits, d.crc.Width)
+		if computed := frameChecksum(d.crc, frame, outBits); computed != received {
+			return newDecodeError(d.rejected, n, &IntegrityError{CRC: d.crc.Name, Received: received, Computed: computed})
+		}
+	}
+	if d.rejected != nil {
+		return newDecodeError(d.rejected, n, ErrRejected)
+	}
+	return nil
+}
+
+// newOutputWriter validates that v is a pointer to a slice of BinaryValue type
+// and returns a bit writer for its element type.
+func newOutputWriter(v any) (sliceWriter, reflect.Value, error) {
 	if v == nil {
-		return errors.New("v must not be nil")
+		return nil, reflect.Value{}, ErrNilOutput
 	}
 	// Type check: ensure v is a pointer
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr {
-		return errors.New("v must be a pointer to a slice")
+		return nil, rv, ErrNotSlicePointer
 	}
 	// Ensure the pointer points to a slice
 	elem := rv.Elem()
 	if elem.Kind() != reflect.Slice {
-		return errors.New("v must be a pointer to a slice")
-	}
-	var writer interface {
-		Write16(int, int, uint16)
-		AnyData() any
+		return nil, rv, ErrNotSlicePointer
 	}
-	elemType := elem.Type().Elem()
-	switch elemType.Kind() {
+	switch elem.Type().Elem().Kind() {
 	case reflect.Uint64:
-		writer = bitstream.NewBitWriter[uint64](0, 0)
+		return bitstream.NewBitWriter[uint64](0, 0), rv, nil
 	case reflect.Uint32:
-		writer = bitstream.NewBitWriter[uint32](0, 0)
+		return bitstream.NewBitWriter[uint32](0, 0), rv, nil
 	case reflect.Uint16:
-		writer = bitstream.NewBitWriter[uint16](0, 0)
+		return bitstream.NewBitWriter[uint16](0, 0), rv, nil
 	case reflect.Uint8:
-		writer = bitstream.NewBitWriter[uint8](0, 0)
+		return bitstream.NewBitWriter[uint8](0, 0), rv, nil
 	case reflect.Uint:
-		writer = bitstream.NewBitWriter[uint](0, 0)
+		return bitstream.NewBitWriter[uint](0, 0), rv, nil
 	default:
 		// Ensure the slice element type satisfies BinaryValue constraint
-		return errors.New("slice element type must satisfy BinaryValue constraint")
+		return nil, rv, ErrElemType
 	}
-
-	numBlocks := d.reader.Bits() / 23
-	for i := range numBlocks {
-		cw := d.reader.Read32R(23, i)
-		b := Decode(cw)
-		// right 12 bits are data
-		writer.Write16(4, 12, b)
-	}
-	data := writer.AnyData()
-	rv.Elem().Set(reflect.ValueOf(data))
-	return nil
 }
 
 // Bits returns the total number of bits in the decoded output.
-// This method decodes only complete 23-bit blocks, discarding any incomplete data.
+// This method decodes only complete blocks, discarding any incomplete data.
 // For example, 48 bits of input data will be decoded as 2 blocks (12 bits × 2 = 24 bits),
 // and the remaining 2 bits will be ignored.
+// With CRC verification enabled, the check value is excluded.
 func (d *Decoder[T]) Bits() int {
-	return d.reader.Bits() / 23 * 12
+	return max(d.reader.Bits()/d.blockBits()*12-d.crc.Width, 0)
 }
 
 // DecodedBits calculates the number of bits that would result from decoding